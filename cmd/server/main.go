@@ -3,9 +3,11 @@ package main
 import (
 	"log"
 	"os"
+	_ "time/tzdata"
 
 	"github.com/SteelyBretty/consultant-time-tracker/internal/api"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 )
@@ -38,6 +40,10 @@ func main() {
 		log.Fatal("Failed to create indexes:", err)
 	}
 
+	if err := services.NewAuthService().SyncAdmins(); err != nil {
+		log.Fatal("Failed to sync admin users:", err)
+	}
+
 	r := gin.Default()
 
 	r.GET("/health", func(c *gin.Context) {
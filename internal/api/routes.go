@@ -10,8 +10,30 @@ func SetupRoutes(router *gin.Engine) {
 	authHandler := handlers.NewAuthHandler()
 	clientHandler := handlers.NewClientHandler()
 	projectHandler := handlers.NewProjectHandler()
+	projectRateHandler := handlers.NewProjectRateHandler()
+	projectMemberHandler := handlers.NewProjectMemberHandler()
+	milestoneHandler := handlers.NewMilestoneHandler()
+	budgetHandler := handlers.NewBudgetHandler()
+	notificationHandler := handlers.NewNotificationHandler()
 	allocationHandler := handlers.NewAllocationHandler()
 	timeEntryHandler := handlers.NewTimeEntryHandler()
+	attachmentHandler := handlers.NewAttachmentHandler()
+	overtimeHandler := handlers.NewOvertimeHandler()
+	holidayHandler := handlers.NewHolidayHandler()
+	leaveHandler := handlers.NewLeaveHandler()
+	reportHandler := handlers.NewReportHandler()
+	expenseHandler := handlers.NewExpenseHandler()
+	travelHandler := handlers.NewTravelHandler()
+	travelRateHandler := handlers.NewTravelRateHandler()
+	exchangeRateHandler := handlers.NewExchangeRateHandler()
+	taxRateHandler := handlers.NewTaxRateHandler()
+	invoiceHandler := handlers.NewInvoiceHandler()
+	paymentHandler := handlers.NewPaymentHandler()
+	creditNoteHandler := handlers.NewCreditNoteHandler()
+	writeDownHandler := handlers.NewWriteDownHandler()
+	accountingHandler := handlers.NewAccountingHandler()
+	companyProfileHandler := handlers.NewCompanyProfileHandler()
+	adminHandler := handlers.NewAdminHandler()
 	api := router.Group("/api/v1")
 	{
 		auth := api.Group("/auth")
@@ -19,6 +41,7 @@ func SetupRoutes(router *gin.Engine) {
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
 			auth.GET("/me", middleware.BasicAuth(), authHandler.GetCurrentUser)
+			auth.PUT("/me", middleware.BasicAuth(), authHandler.UpdateCurrentUser)
 		}
 
 		protected := api.Group("/")
@@ -28,7 +51,11 @@ func SetupRoutes(router *gin.Engine) {
 			{
 				clients.POST("", clientHandler.CreateClient)
 				clients.GET("", clientHandler.ListClients)
+				clients.GET("/trash", clientHandler.ListDeletedClients)
+				clients.POST("/:id/restore", clientHandler.RestoreClient)
+				clients.DELETE("/:id/purge", clientHandler.PurgeClient)
 				clients.GET("/:id", clientHandler.GetClient)
+				clients.GET("/:id/credit", paymentHandler.GetClientCredit)
 				clients.PUT("/:id", clientHandler.UpdateClient)
 				clients.DELETE("/:id", clientHandler.DeleteClient)
 			}
@@ -37,9 +64,30 @@ func SetupRoutes(router *gin.Engine) {
 			{
 				projects.POST("", projectHandler.CreateProject)
 				projects.GET("", projectHandler.ListProjects)
+				projects.GET("/trash", projectHandler.ListDeletedProjects)
+				projects.POST("/:id/restore", projectHandler.RestoreProject)
+				projects.DELETE("/:id/purge", projectHandler.PurgeProject)
 				projects.GET("/:id", projectHandler.GetProject)
 				projects.PUT("/:id", projectHandler.UpdateProject)
 				projects.DELETE("/:id", projectHandler.DeleteProject)
+				projects.GET("/:id/rates", projectRateHandler.ListRates)
+				projects.POST("/:id/rates", projectRateHandler.SetRate)
+				projects.DELETE("/:id/rates/:rateId", projectRateHandler.DeleteRate)
+				projects.GET("/:id/members", projectMemberHandler.ListMembers)
+				projects.POST("/:id/members", projectMemberHandler.AddMember)
+				projects.DELETE("/:id/members/:userId", projectMemberHandler.RemoveMember)
+				projects.GET("/:id/member-rates", projectRateHandler.ListMemberRates)
+				projects.POST("/:id/member-rates", projectRateHandler.SetMemberRate)
+				projects.DELETE("/:id/member-rates/:rateId", projectRateHandler.DeleteMemberRate)
+				projects.GET("/:id/milestones", milestoneHandler.ListMilestones)
+				projects.POST("/:id/milestones", milestoneHandler.CreateMilestone)
+				projects.PUT("/:id/milestones/:milestoneId", milestoneHandler.UpdateMilestone)
+				projects.DELETE("/:id/milestones/:milestoneId", milestoneHandler.DeleteMilestone)
+				projects.GET("/:id/budgets", budgetHandler.ListBudgets)
+				projects.POST("/:id/budgets", budgetHandler.CreateBudget)
+				projects.PUT("/:id/budgets/:budgetId", budgetHandler.UpdateBudget)
+				projects.DELETE("/:id/budgets/:budgetId", budgetHandler.DeleteBudget)
+				projects.GET("/:id/burn", budgetHandler.GetBurn)
 			}
 
 			allocations := protected.Group("/allocations")
@@ -47,7 +95,11 @@ func SetupRoutes(router *gin.Engine) {
 				allocations.POST("", allocationHandler.CreateAllocation)
 				allocations.GET("", allocationHandler.ListAllocations)
 				allocations.GET("/week", allocationHandler.GetWeekAllocations)
+				allocations.GET("/trash", allocationHandler.ListDeletedAllocations)
+				allocations.POST("/:id/restore", allocationHandler.RestoreAllocation)
+				allocations.DELETE("/:id/purge", allocationHandler.PurgeAllocation)
 				allocations.GET("/:id", allocationHandler.GetAllocation)
+				allocations.GET("/:id/history", allocationHandler.GetAllocationHistory)
 				allocations.PUT("/:id", allocationHandler.UpdateAllocation)
 				allocations.DELETE("/:id", allocationHandler.DeleteAllocation)
 				allocations.POST("/copy", allocationHandler.CopyWeekAllocations)
@@ -60,11 +112,188 @@ func SetupRoutes(router *gin.Engine) {
 				timeEntries.GET("/day", timeEntryHandler.GetDayEntries)
 				timeEntries.GET("/week", timeEntryHandler.GetWeekEntries)
 				timeEntries.GET("/week-summary", timeEntryHandler.GetWeekSummary)
+				timeEntries.GET("/month", timeEntryHandler.GetMonthEntries)
+				timeEntries.GET("/month-summary", timeEntryHandler.GetMonthSummary)
+				timeEntries.GET("/overtime", timeEntryHandler.GetOvertimeReport)
+				timeEntries.GET("/billing", timeEntryHandler.GetBillingSummary)
+				timeEntries.GET("/trash", timeEntryHandler.ListDeletedTimeEntries)
+				timeEntries.POST("/:id/restore", timeEntryHandler.RestoreTimeEntry)
+				timeEntries.DELETE("/:id/purge", timeEntryHandler.PurgeTimeEntry)
 				timeEntries.GET("/projects/:projectId/week-comparison", timeEntryHandler.GetProjectWeekComparison)
 				timeEntries.GET("/:id", timeEntryHandler.GetTimeEntry)
+				timeEntries.GET("/:id/history", timeEntryHandler.GetTimeEntryHistory)
+				timeEntries.POST("/:id/attachments", attachmentHandler.UploadAttachment)
+				timeEntries.GET("/:id/attachments", attachmentHandler.ListAttachments)
+				timeEntries.GET("/:id/attachments/:attachmentId", attachmentHandler.DownloadAttachment)
+				timeEntries.DELETE("/:id/attachments/:attachmentId", attachmentHandler.DeleteAttachment)
+				timeEntries.GET("/:id/write-downs", writeDownHandler.ListTimeEntryWriteDowns)
+				timeEntries.POST("/:id/write-downs", writeDownHandler.WriteDownTimeEntry)
 				timeEntries.PUT("/:id", timeEntryHandler.UpdateTimeEntry)
 				timeEntries.DELETE("/:id", timeEntryHandler.DeleteTimeEntry)
 			}
+
+			expenses := protected.Group("/expenses")
+			{
+				expenses.POST("", expenseHandler.CreateExpense)
+				expenses.GET("", expenseHandler.ListExpenses)
+				expenses.GET("/:id", expenseHandler.GetExpense)
+				expenses.PUT("/:id", expenseHandler.UpdateExpense)
+				expenses.DELETE("/:id", expenseHandler.DeleteExpense)
+				expenses.POST("/:id/receipt", expenseHandler.UploadReceipt)
+				expenses.GET("/:id/receipt", expenseHandler.DownloadReceipt)
+			}
+
+			mileage := protected.Group("/mileage")
+			{
+				mileage.POST("", travelHandler.CreateMileageEntry)
+				mileage.GET("", travelHandler.ListMileageEntries)
+				mileage.GET("/:id", travelHandler.GetMileageEntry)
+				mileage.PUT("/:id", travelHandler.UpdateMileageEntry)
+				mileage.DELETE("/:id", travelHandler.DeleteMileageEntry)
+			}
+
+			perDiems := protected.Group("/per-diems")
+			{
+				perDiems.POST("", travelHandler.CreatePerDiemClaim)
+				perDiems.GET("", travelHandler.ListPerDiemClaims)
+				perDiems.GET("/:id", travelHandler.GetPerDiemClaim)
+				perDiems.PUT("/:id", travelHandler.UpdatePerDiemClaim)
+				perDiems.DELETE("/:id", travelHandler.DeletePerDiemClaim)
+			}
+
+			travelRates := protected.Group("/travel-rates")
+			{
+				travelRates.POST("/mileage", middleware.RequireAdmin(), travelRateHandler.CreateMileageRate)
+				travelRates.GET("/mileage", travelRateHandler.ListMileageRates)
+				travelRates.DELETE("/mileage/:id", middleware.RequireAdmin(), travelRateHandler.DeleteMileageRate)
+				travelRates.POST("/per-diem", middleware.RequireAdmin(), travelRateHandler.CreatePerDiemRate)
+				travelRates.GET("/per-diem", travelRateHandler.ListPerDiemRates)
+				travelRates.DELETE("/per-diem/:id", middleware.RequireAdmin(), travelRateHandler.DeletePerDiemRate)
+			}
+
+			exchangeRates := protected.Group("/exchange-rates")
+			{
+				exchangeRates.POST("", middleware.RequireAdmin(), exchangeRateHandler.SetRate)
+				exchangeRates.GET("", exchangeRateHandler.ListRates)
+				exchangeRates.POST("/import", middleware.RequireAdmin(), exchangeRateHandler.ImportRates)
+				exchangeRates.DELETE("/:id", middleware.RequireAdmin(), exchangeRateHandler.DeleteRate)
+			}
+
+			taxRates := protected.Group("/tax-rates")
+			{
+				taxRates.POST("", middleware.RequireAdmin(), taxRateHandler.CreateRate)
+				taxRates.GET("", taxRateHandler.ListRates)
+				taxRates.DELETE("/:id", middleware.RequireAdmin(), taxRateHandler.DeleteRate)
+			}
+
+			invoices := protected.Group("/invoices")
+			{
+				invoices.POST("", invoiceHandler.CreateInvoice)
+				invoices.GET("", invoiceHandler.ListInvoices)
+				invoices.GET("/:id", invoiceHandler.GetInvoice)
+				invoices.GET("/:id/pdf", invoiceHandler.DownloadInvoicePDF)
+				invoices.GET("/:id/ubl", invoiceHandler.DownloadInvoiceUBL)
+				invoices.POST("/:id/issue", invoiceHandler.IssueInvoice)
+				invoices.POST("/:id/pay", invoiceHandler.PayInvoice)
+				invoices.POST("/:id/void", invoiceHandler.VoidInvoice)
+				invoices.GET("/:id/payments", paymentHandler.ListInvoicePayments)
+				invoices.POST("/:id/payments", paymentHandler.RecordPayment)
+				invoices.POST("/:id/apply-credit", paymentHandler.ApplyCredit)
+				invoices.GET("/:id/credit-notes", creditNoteHandler.ListInvoiceCreditNotes)
+				invoices.GET("/:id/write-downs", writeDownHandler.ListInvoiceWriteDowns)
+				invoices.POST("/:id/lines/:lineId/write-downs", writeDownHandler.WriteDownInvoiceLine)
+				invoices.POST("/:id/credit-notes", creditNoteHandler.CreateCreditNote)
+				invoices.DELETE("/:id", invoiceHandler.DeleteInvoice)
+			}
+
+			creditNotes := protected.Group("/credit-notes")
+			{
+				creditNotes.GET("", creditNoteHandler.ListCreditNotes)
+				creditNotes.GET("/:id", creditNoteHandler.GetCreditNote)
+			}
+
+			writeDowns := protected.Group("/write-downs")
+			{
+				writeDowns.DELETE("/:id", writeDownHandler.DeleteWriteDown)
+			}
+
+			accountMappings := protected.Group("/account-mappings")
+			{
+				accountMappings.POST("", accountingHandler.CreateMapping)
+				accountMappings.GET("", accountingHandler.ListMappings)
+				accountMappings.PUT("/:id", accountingHandler.UpdateMapping)
+				accountMappings.DELETE("/:id", accountingHandler.DeleteMapping)
+			}
+
+			exports := protected.Group("/exports")
+			{
+				exports.GET("/accounting", accountingHandler.ExportAccounting)
+			}
+
+			payments := protected.Group("/payments")
+			{
+				payments.GET("", paymentHandler.ListPayments)
+				payments.POST("/match-statement", paymentHandler.MatchStatement)
+				payments.DELETE("/:id", paymentHandler.DeletePayment)
+			}
+
+			companyProfile := protected.Group("/company-profile")
+			{
+				companyProfile.GET("", companyProfileHandler.GetProfile)
+				companyProfile.PUT("", companyProfileHandler.UpdateProfile)
+				companyProfile.POST("/logo", companyProfileHandler.UploadLogo)
+			}
+
+			overtimeRules := protected.Group("/overtime-rules")
+			{
+				overtimeRules.POST("", overtimeHandler.CreateRule)
+				overtimeRules.GET("", overtimeHandler.ListRules)
+				overtimeRules.GET("/:id", overtimeHandler.GetRule)
+				overtimeRules.PUT("/:id", overtimeHandler.UpdateRule)
+				overtimeRules.DELETE("/:id", overtimeHandler.DeleteRule)
+			}
+
+			holidays := protected.Group("/holidays")
+			{
+				holidays.POST("", middleware.RequireAdmin(), holidayHandler.CreateHoliday)
+				holidays.GET("", holidayHandler.ListHolidays)
+				holidays.DELETE("/:id", middleware.RequireAdmin(), holidayHandler.DeleteHoliday)
+			}
+
+			leave := protected.Group("/leave")
+			{
+				leave.POST("", leaveHandler.CreateLeave)
+				leave.GET("", leaveHandler.ListLeave)
+				leave.DELETE("/:id", leaveHandler.DeleteLeave)
+			}
+
+			reports := protected.Group("/reports")
+			{
+				reports.GET("/missing-timesheets", middleware.RequireAdmin(), reportHandler.GetMissingTimesheets)
+				reports.GET("/projects/:id", reportHandler.GetProjectReport)
+				reports.GET("/projects/:id/consumption", reportHandler.GetProjectConsumption)
+				reports.GET("/clients/:id", reportHandler.GetClientReport)
+				reports.GET("/clients/:id/timesheet.pdf", reportHandler.DownloadClientTimesheet)
+				reports.GET("/tax", reportHandler.GetTaxReport)
+				reports.GET("/aging", reportHandler.GetAgingReport)
+				reports.GET("/realization", reportHandler.GetRealizationReport)
+				reports.GET("/utilization", middleware.RequireAdmin(), reportHandler.GetUtilizationReport)
+				reports.GET("/revenue", reportHandler.GetRevenueReport)
+				reports.GET("/revenue-forecast", reportHandler.GetRevenueForecast)
+			}
+
+			notifications := protected.Group("/notifications")
+			{
+				notifications.GET("", notificationHandler.ListNotifications)
+				notifications.POST("/read-all", notificationHandler.MarkAllRead)
+				notifications.POST("/:id/read", notificationHandler.MarkRead)
+			}
+
+			admin := protected.Group("/admin")
+			admin.Use(middleware.RequireAdmin())
+			{
+				admin.GET("/lock-overrides", adminHandler.ListLockOverrides)
+			}
 		}
 	}
 }
@@ -15,6 +15,36 @@ func Migrate() error {
 		&models.Project{},
 		&models.Allocation{},
 		&models.TimeEntry{},
+		&models.Revision{},
+		&models.Attachment{},
+		&models.Holiday{},
+		&models.OvertimeRule{},
+		&models.Expense{},
+		&models.MileageRate{},
+		&models.PerDiemRate{},
+		&models.MileageEntry{},
+		&models.PerDiemClaim{},
+		&models.NumberSequence{},
+		&models.Invoice{},
+		&models.InvoiceLine{},
+		&models.CompanyProfile{},
+		&models.ProjectRate{},
+		&models.ProjectMember{},
+		&models.ProjectMemberRate{},
+		&models.ProjectMilestone{},
+		&models.ProjectBudget{},
+		&models.BudgetAlert{},
+		&models.Notification{},
+		&models.ExchangeRate{},
+		&models.TaxRate{},
+		&models.InvoiceTaxLine{},
+		&models.Payment{},
+		&models.ClientCredit{},
+		&models.CreditNote{},
+		&models.CreditNoteLine{},
+		&models.WriteDown{},
+		&models.AccountMapping{},
+		&models.Leave{},
 	)
 
 	if err != nil {
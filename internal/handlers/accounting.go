@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AccountingHandler struct {
+	accountingService *services.AccountingService
+}
+
+func NewAccountingHandler() *AccountingHandler {
+	return &AccountingHandler{
+		accountingService: services.NewAccountingService(),
+	}
+}
+
+func (h *AccountingHandler) CreateMapping(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateAccountMappingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	mapping, err := h.accountingService.CreateMapping(userID, models.AccountMapping{
+		ClientID:          req.ClientID,
+		ProjectID:         req.ProjectID,
+		ReceivableAccount: req.ReceivableAccount,
+		RevenueAccount:    req.RevenueAccount,
+		TaxAccount:        req.TaxAccount,
+		BankAccount:       req.BankAccount,
+	})
+	if err != nil {
+		h.handleMappingError(c, err, "Failed to create account mapping")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapAccountMappingToResponse(mapping))
+}
+
+func (h *AccountingHandler) ListMappings(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	mappings, err := h.accountingService.ListMappings(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch account mappings"})
+		return
+	}
+
+	defaults, err := h.accountingService.DefaultAccounts(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch account mappings"})
+		return
+	}
+
+	response := schemas.AccountMappingListResponse{
+		Mappings: make([]schemas.AccountMappingResponse, len(mappings)),
+		Defaults: schemas.AccountMappingDefaults{
+			ReceivableAccount: defaults.ReceivableAccount,
+			RevenueAccount:    defaults.RevenueAccount,
+			TaxAccount:        defaults.TaxAccount,
+			BankAccount:       defaults.BankAccount,
+		},
+	}
+	for i, mapping := range mappings {
+		response.Mappings[i] = *mapAccountMappingToResponse(mapping)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AccountingHandler) UpdateMapping(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	mappingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account mapping ID"})
+		return
+	}
+
+	var req schemas.UpdateAccountMappingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.ReceivableAccount != nil {
+		updates["receivable_account"] = *req.ReceivableAccount
+	}
+	if req.RevenueAccount != nil {
+		updates["revenue_account"] = *req.RevenueAccount
+	}
+	if req.TaxAccount != nil {
+		updates["tax_account"] = *req.TaxAccount
+	}
+	if req.BankAccount != nil {
+		updates["bank_account"] = *req.BankAccount
+	}
+
+	mapping, err := h.accountingService.UpdateMapping(userID, mappingID, updates)
+	if err != nil {
+		h.handleMappingError(c, err, "Failed to update account mapping")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapAccountMappingToResponse(mapping))
+}
+
+func (h *AccountingHandler) DeleteMapping(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	mappingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid account mapping ID"})
+		return
+	}
+
+	if err := h.accountingService.DeleteMapping(userID, mappingID); err != nil {
+		h.handleMappingError(c, err, "Failed to delete account mapping")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *AccountingHandler) ExportAccounting(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	format := services.AccountingExportFormat(c.Query("format"))
+
+	var buf bytes.Buffer
+	if err := h.accountingService.ExportAccounting(userID, format, startDate, endDate, &buf); err != nil {
+		if err == services.ErrInvalidExportFormat {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export accounting data"})
+		return
+	}
+
+	extension, contentType := "csv", "text/csv"
+	if format == services.AccountingExportIIF {
+		extension, contentType = "iif", "text/plain"
+	}
+	fileName := fmt.Sprintf("accounting-%s-%s-%s.%s", format, startDate.Format("20060102"), endDate.Format("20060102"), extension)
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+func (h *AccountingHandler) handleMappingError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrAccountMappingNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Account mapping not found"})
+	case services.ErrAccountMappingExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case services.ErrAccountMappingScope:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrClientNotFound:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "client not found or access denied"})
+	case services.ErrProjectNotFound:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "project not found or access denied"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapAccountMappingToResponse(mapping *models.AccountMapping) *schemas.AccountMappingResponse {
+	response := &schemas.AccountMappingResponse{
+		ID:                mapping.ID,
+		ClientID:          mapping.ClientID,
+		ProjectID:         mapping.ProjectID,
+		ReceivableAccount: mapping.ReceivableAccount,
+		RevenueAccount:    mapping.RevenueAccount,
+		TaxAccount:        mapping.TaxAccount,
+		BankAccount:       mapping.BankAccount,
+		CreatedAt:         mapping.CreatedAt,
+		UpdatedAt:         mapping.UpdatedAt,
+	}
+
+	if mapping.Client != nil {
+		response.Client = &schemas.ClientSummary{
+			ID:   mapping.Client.ID,
+			Name: mapping.Client.Name,
+			Code: mapping.Client.Code,
+		}
+	}
+
+	if mapping.Project != nil {
+		response.Project = &schemas.ProjectSummary{
+			ID:           mapping.Project.ID,
+			Name:         mapping.Project.Name,
+			Code:         mapping.Project.Code,
+			BillableRate: mapping.Project.BillableRate,
+			Currency:     mapping.Project.Currency,
+			Client: schemas.ClientSummary{
+				ID:   mapping.Project.Client.ID,
+				Name: mapping.Project.Client.Name,
+				Code: mapping.Project.Client.Code,
+			},
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	revisionService *services.RevisionService
+}
+
+func NewAdminHandler() *AdminHandler {
+	return &AdminHandler{
+		revisionService: services.NewRevisionService(),
+	}
+}
+
+func (h *AdminHandler) ListLockOverrides(c *gin.Context) {
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	revisions, total, err := h.revisionService.ListOverrides(offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list lock overrides"})
+		return
+	}
+
+	response := schemas.OverrideAuditListResponse{
+		Overrides: make([]schemas.OverrideAuditResponse, len(revisions)),
+		Total:     total,
+		Offset:    offset,
+		Limit:     limit,
+	}
+	for i, revision := range revisions {
+		response.Overrides[i] = schemas.OverrideAuditResponse{
+			EntityType:       revision.EntityType,
+			EntityID:         revision.EntityID,
+			RevisionResponse: mapRevisionToResponse(revision),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -283,6 +283,121 @@ func (h *AllocationHandler) CopyWeekAllocations(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (h *AllocationHandler) GetAllocationHistory(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	allocationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid allocation ID"})
+		return
+	}
+
+	revisions, err := h.allocationService.GetAllocationHistory(userID, allocationID)
+	if err != nil {
+		if err == services.ErrAllocationNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Allocation not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch allocation history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapRevisionHistoryToResponse(models.RevisionEntityAllocation, allocationID, revisions))
+}
+
+func (h *AllocationHandler) ListDeletedAllocations(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	allocations, total, err := h.allocationService.ListDeletedAllocations(userID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch deleted allocations"})
+		return
+	}
+
+	response := schemas.AllocationListResponse{
+		Allocations: make([]schemas.AllocationResponse, len(allocations)),
+		Total:       total,
+		Offset:      offset,
+		Limit:       limit,
+	}
+
+	for i, allocation := range allocations {
+		response.Allocations[i] = *h.mapAllocationToResponse(allocation)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AllocationHandler) RestoreAllocation(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	allocationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid allocation ID"})
+		return
+	}
+
+	allocation, err := h.allocationService.RestoreAllocation(userID, allocationID)
+	if err != nil {
+		switch err {
+		case services.ErrAllocationNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Allocation not found in trash"})
+		case services.ErrAllocationExists, services.ErrProjectDeleted:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore allocation"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapAllocationToResponse(allocation))
+}
+
+func (h *AllocationHandler) PurgeAllocation(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	allocationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid allocation ID"})
+		return
+	}
+
+	if err := h.allocationService.PurgeAllocation(userID, allocationID); err != nil {
+		switch err {
+		case services.ErrAllocationNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Allocation not found in trash"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to purge allocation"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h *AllocationHandler) mapAllocationToResponse(allocation *models.Allocation) *schemas.AllocationResponse {
 	response := &schemas.AllocationResponse{
 		ID:           allocation.ID,
@@ -294,6 +409,11 @@ func (h *AllocationHandler) mapAllocationToResponse(allocation *models.Allocatio
 		UpdatedAt:    allocation.UpdatedAt,
 	}
 
+	if allocation.DeletedAt.Valid {
+		deletedAt := allocation.DeletedAt.Time
+		response.DeletedAt = &deletedAt
+	}
+
 	if allocation.Project.ID != uuid.Nil {
 		response.Project = &schemas.ProjectSummary{
 			ID:           allocation.Project.ID,
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AttachmentHandler struct {
+	attachmentService *services.AttachmentService
+}
+
+func NewAttachmentHandler() *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: services.NewAttachmentService(),
+	}
+}
+
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "File is required",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentService.UploadAttachment(userID, timeEntryID, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		switch err {
+		case services.ErrTimeEntryNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
+		case services.ErrFileTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case services.ErrFileTypeForbidden:
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		case services.ErrFileEmpty:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload attachment"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapAttachmentToResponse(attachment))
+}
+
+func (h *AttachmentHandler) ListAttachments(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	attachments, err := h.attachmentService.ListAttachments(userID, timeEntryID)
+	if err != nil {
+		if err == services.ErrTimeEntryNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch attachments"})
+		return
+	}
+
+	response := schemas.AttachmentListResponse{
+		TimeEntryID: timeEntryID,
+		Attachments: make([]schemas.AttachmentResponse, len(attachments)),
+	}
+
+	for i, attachment := range attachments {
+		response.Attachments[i] = *h.mapAttachmentToResponse(attachment)
+		response.TotalSize += attachment.Size
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	attachment, reader, err := h.attachmentService.OpenAttachment(userID, timeEntryID, attachmentID)
+	if err != nil {
+		if err == services.ErrAttachmentNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Attachment not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to download attachment"})
+		return
+	}
+	defer reader.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, reader, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", attachment.FileName),
+		"X-Checksum-SHA256":   attachment.Checksum,
+	})
+}
+
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	if err := h.attachmentService.DeleteAttachment(userID, timeEntryID, attachmentID); err != nil {
+		if err == services.ErrAttachmentNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Attachment not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete attachment"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *AttachmentHandler) mapAttachmentToResponse(attachment *models.Attachment) *schemas.AttachmentResponse {
+	return &schemas.AttachmentResponse{
+		ID:          attachment.ID,
+		TimeEntryID: attachment.TimeEntryID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
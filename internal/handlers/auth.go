@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
@@ -43,13 +45,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusCreated, schemas.AuthResponse{
-		User: schemas.UserResponse{
-			ID:       user.ID,
-			Username: user.Username,
-			Email:    user.Email,
-			FullName: user.FullName,
-			IsActive: user.IsActive,
-		},
+		User:    *mapUserToResponse(user),
 		Message: "User registered successfully",
 	})
 }
@@ -78,13 +74,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, schemas.AuthResponse{
-		User: schemas.UserResponse{
-			ID:       user.ID,
-			Username: user.Username,
-			Email:    user.Email,
-			FullName: user.FullName,
-			IsActive: user.IsActive,
-		},
+		User:    *mapUserToResponse(user),
 		Message: "Login successful",
 	})
 }
@@ -99,11 +89,66 @@ func (h *AuthHandler) GetCurrentUser(c *gin.Context) {
 	}
 
 	currentUser := user.(*models.User)
-	c.JSON(http.StatusOK, schemas.UserResponse{
-		ID:       currentUser.ID,
-		Username: currentUser.Username,
-		Email:    currentUser.Email,
-		FullName: currentUser.FullName,
-		IsActive: currentUser.IsActive,
-	})
+	c.JSON(http.StatusOK, mapUserToResponse(currentUser))
+}
+
+func (h *AuthHandler) UpdateCurrentUser(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.FullName != nil {
+		updates["full_name"] = *req.FullName
+	}
+	if req.WeeklyCapacity != nil {
+		updates["weekly_capacity"] = *req.WeeklyCapacity
+	}
+	if req.ReportingCurrency != nil {
+		updates["reporting_currency"] = strings.ToUpper(*req.ReportingCurrency)
+	}
+	if req.TimeZone != nil {
+		updates["time_zone"] = *req.TimeZone
+	}
+	if req.UtilizationTarget != nil {
+		updates["utilization_target"] = *req.UtilizationTarget
+	}
+
+	user, err := h.authService.UpdateProfile(userID, updates)
+	if err != nil {
+		if err == services.ErrInvalidTimeZone {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update profile"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapUserToResponse(user))
+}
+
+func mapUserToResponse(user *models.User) *schemas.UserResponse {
+	return &schemas.UserResponse{
+		ID:                user.ID,
+		Username:          user.Username,
+		Email:             user.Email,
+		FullName:          user.FullName,
+		IsActive:          user.IsActive,
+		IsAdmin:           user.IsAdmin,
+		WeeklyCapacity:    user.WeeklyCapacity,
+		ReportingCurrency: user.ReportingCurrency,
+		TimeZone:          user.TimeZone,
+		UtilizationTarget: user.UtilizationTarget,
+	}
 }
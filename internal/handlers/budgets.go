@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BudgetHandler struct {
+	budgetService *services.BudgetService
+}
+
+func NewBudgetHandler() *BudgetHandler {
+	return &BudgetHandler{
+		budgetService: services.NewBudgetService(),
+	}
+}
+
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var req schemas.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	budget := &models.ProjectBudget{
+		Phase:      req.Phase,
+		StartDate:  parseOptionalDate(req.StartDate),
+		EndDate:    parseOptionalDate(req.EndDate),
+		Hours:      req.Hours,
+		Amount:     req.Amount,
+		Thresholds: req.Thresholds,
+	}
+
+	budget, err = h.budgetService.CreateBudget(userID, projectID, budget)
+	if err != nil {
+		handleBudgetError(c, err, "Failed to create budget")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapBudgetToResponse(budget))
+}
+
+func (h *BudgetHandler) ListBudgets(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	budgets, err := h.budgetService.ListBudgets(userID, projectID)
+	if err != nil {
+		handleBudgetError(c, err, "Failed to fetch budgets")
+		return
+	}
+
+	response := schemas.BudgetListResponse{
+		ProjectID: projectID,
+		Budgets:   make([]schemas.BudgetResponse, len(budgets)),
+	}
+	for i, budget := range budgets {
+		response.Budgets[i] = *mapBudgetToResponse(budget)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	budgetID, err := uuid.Parse(c.Param("budgetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid budget ID"})
+		return
+	}
+
+	var req schemas.UpdateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Phase != nil {
+		updates["phase"] = *req.Phase
+	}
+	if req.StartDate != nil {
+		updates["start_date"] = parseOptionalDate(req.StartDate)
+	}
+	if req.EndDate != nil {
+		updates["end_date"] = parseOptionalDate(req.EndDate)
+	}
+	if req.Hours != nil {
+		updates["hours"] = *req.Hours
+	}
+	if req.Amount != nil {
+		updates["amount"] = *req.Amount
+	}
+	if req.Thresholds != nil {
+		updates["thresholds"] = req.Thresholds
+	}
+
+	budget, err := h.budgetService.UpdateBudget(userID, projectID, budgetID, updates)
+	if err != nil {
+		handleBudgetError(c, err, "Failed to update budget")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapBudgetToResponse(budget))
+}
+
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	budgetID, err := uuid.Parse(c.Param("budgetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid budget ID"})
+		return
+	}
+
+	if err := h.budgetService.DeleteBudget(userID, projectID, budgetID); err != nil {
+		handleBudgetError(c, err, "Failed to delete budget")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *BudgetHandler) GetBurn(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	asOf := time.Now().UTC().Truncate(24 * time.Hour)
+	if value := c.Query("as_of"); value != "" {
+		asOf, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid as_of date format. Use YYYY-MM-DD"})
+			return
+		}
+	}
+
+	project, burns, err := h.budgetService.GetBurn(userID, projectID, asOf)
+	if err != nil {
+		handleBudgetError(c, err, "Failed to calculate budget burn")
+		return
+	}
+
+	response := schemas.ProjectBurnResponse{
+		Project: schemas.ProjectSummary{
+			ID:           project.ID,
+			Name:         project.Name,
+			Code:         project.Code,
+			BillableRate: project.BillableRate,
+			Currency:     project.Currency,
+			Client: schemas.ClientSummary{
+				ID:   project.Client.ID,
+				Name: project.Client.Name,
+				Code: project.Client.Code,
+			},
+		},
+		AsOf:    asOf.Format("2006-01-02"),
+		Budgets: make([]schemas.BudgetBurnResponse, len(burns)),
+	}
+
+	for i, burn := range burns {
+		response.Budgets[i] = schemas.BudgetBurnResponse{
+			Budget:          *mapBudgetToResponse(&burn.Budget),
+			WindowStart:     burn.WindowStart.Format("2006-01-02"),
+			WindowEnd:       formatOptionalDate(burn.WindowEnd),
+			LoggedHours:     burn.LoggedHours,
+			LoggedValue:     burn.LoggedValue,
+			PlannedHours:    burn.PlannedHours,
+			PlannedValue:    burn.PlannedValue,
+			RemainingHours:  burn.RemainingHours,
+			RemainingAmount: burn.RemainingAmount,
+			ProjectedHours:  burn.ProjectedHours,
+			ProjectedValue:  burn.ProjectedValue,
+			HoursPercent:    burn.HoursPercent,
+			AmountPercent:   burn.AmountPercent,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func handleBudgetError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrProjectNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+	case services.ErrBudgetNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Budget not found"})
+	case services.ErrBudgetEmpty, services.ErrInvalidBudgetThreshold:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrBudgetPhaseExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapBudgetToResponse(budget *models.ProjectBudget) *schemas.BudgetResponse {
+	response := &schemas.BudgetResponse{
+		ID:         budget.ID,
+		ProjectID:  budget.ProjectID,
+		Phase:      budget.Phase,
+		Hours:      budget.Hours,
+		Amount:     budget.Amount,
+		Thresholds: budget.Thresholds,
+		CreatedAt:  budget.CreatedAt,
+		UpdatedAt:  budget.UpdatedAt,
+	}
+
+	if budget.StartDate != nil {
+		response.StartDate = formatOptionalDate((*time.Time)(budget.StartDate))
+	}
+	if budget.EndDate != nil {
+		response.EndDate = formatOptionalDate((*time.Time)(budget.EndDate))
+	}
+
+	return response
+}
@@ -38,13 +38,27 @@ func (h *ClientHandler) CreateClient(c *gin.Context) {
 		return
 	}
 
-	client, err := h.clientService.CreateClient(userID, req.Name, req.Code, req.Email, req.Phone, req.Address)
+	tax := services.TaxProfile{
+		TaxID:   req.TaxID,
+		Country: req.Country,
+		Status:  models.TaxStatus(req.TaxStatus),
+	}
+
+	einvoice := services.EInvoiceProfile{
+		PeppolID:       req.PeppolID,
+		BuyerReference: req.BuyerReference,
+	}
+
+	client, err := h.clientService.CreateClient(userID, req.Name, req.Code, req.Email, req.Phone, req.Address, req.DefaultRate, tax, einvoice)
 	if err != nil {
-		if err == services.ErrClientCodeExists {
+		switch err {
+		case services.ErrClientCodeExists:
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
+		case services.ErrInvalidTaxStatus, services.ErrTaxIDRequired, services.ErrTaxCountryRequired, services.ErrInvalidPeppolID:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create client"})
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create client"})
 		return
 	}
 
@@ -156,6 +170,24 @@ func (h *ClientHandler) UpdateClient(c *gin.Context) {
 	if req.Address != nil {
 		updates["address"] = *req.Address
 	}
+	if req.DefaultRate != nil {
+		updates["default_rate"] = *req.DefaultRate
+	}
+	if req.TaxID != nil {
+		updates["tax_id"] = *req.TaxID
+	}
+	if req.Country != nil {
+		updates["country"] = *req.Country
+	}
+	if req.TaxStatus != nil {
+		updates["tax_status"] = *req.TaxStatus
+	}
+	if req.PeppolID != nil {
+		updates["peppol_id"] = *req.PeppolID
+	}
+	if req.BuyerReference != nil {
+		updates["buyer_reference"] = *req.BuyerReference
+	}
 	if req.IsActive != nil {
 		updates["is_active"] = *req.IsActive
 	}
@@ -170,6 +202,10 @@ func (h *ClientHandler) UpdateClient(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
+		if err == services.ErrTaxIDRequired || err == services.ErrTaxCountryRequired || err == services.ErrInvalidPeppolID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update client"})
 		return
 	}
@@ -202,17 +238,119 @@ func (h *ClientHandler) DeleteClient(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h *ClientHandler) ListDeletedClients(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	clients, total, err := h.clientService.ListDeletedClients(userID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch deleted clients"})
+		return
+	}
+
+	response := schemas.ClientListResponse{
+		Clients: make([]schemas.ClientResponse, len(clients)),
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+	}
+
+	for i, client := range clients {
+		response.Clients[i] = *h.mapClientToResponse(client)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ClientHandler) RestoreClient(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	clientID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+		return
+	}
+
+	client, err := h.clientService.RestoreClient(userID, clientID)
+	if err != nil {
+		switch err {
+		case services.ErrClientNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Client not found in trash"})
+		case services.ErrClientCodeExists:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore client"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapClientToResponse(client))
+}
+
+func (h *ClientHandler) PurgeClient(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	clientID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+		return
+	}
+
+	if err := h.clientService.PurgeClient(userID, clientID); err != nil {
+		switch err {
+		case services.ErrClientNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Client not found in trash"})
+		case services.ErrClientHasProjects:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to purge client"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h *ClientHandler) mapClientToResponse(client *models.Client) *schemas.ClientResponse {
 	response := &schemas.ClientResponse{
-		ID:        client.ID,
-		Name:      client.Name,
-		Code:      client.Code,
-		Email:     client.Email,
-		Phone:     client.Phone,
-		Address:   client.Address,
-		IsActive:  client.IsActive,
-		CreatedAt: client.CreatedAt,
-		UpdatedAt: client.UpdatedAt,
+		ID:             client.ID,
+		Name:           client.Name,
+		Code:           client.Code,
+		Email:          client.Email,
+		Phone:          client.Phone,
+		Address:        client.Address,
+		DefaultRate:    client.DefaultRate,
+		TaxID:          client.TaxID,
+		Country:        client.Country,
+		TaxStatus:      string(client.TaxStatus),
+		PeppolID:       client.PeppolID,
+		BuyerReference: client.BuyerReference,
+		IsActive:       client.IsActive,
+		CreatedAt:      client.CreatedAt,
+		UpdatedAt:      client.UpdatedAt,
+	}
+
+	if client.DeletedAt.Valid {
+		deletedAt := client.DeletedAt.Time
+		response.DeletedAt = &deletedAt
 	}
 
 	if len(client.Projects) > 0 {
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyProfileHandler struct {
+	profileService *services.CompanyProfileService
+}
+
+func NewCompanyProfileHandler() *CompanyProfileHandler {
+	return &CompanyProfileHandler{
+		profileService: services.NewCompanyProfileService(),
+	}
+}
+
+func (h *CompanyProfileHandler) GetProfile(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	profile, err := h.profileService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch company profile"})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapProfileToResponse(profile))
+}
+
+func (h *CompanyProfileHandler) UpdateProfile(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.UpdateCompanyProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Address != nil {
+		updates["address"] = *req.Address
+	}
+	if req.Email != nil {
+		updates["email"] = *req.Email
+	}
+	if req.Phone != nil {
+		updates["phone"] = *req.Phone
+	}
+	if req.Website != nil {
+		updates["website"] = *req.Website
+	}
+	if req.TaxID != nil {
+		updates["tax_id"] = *req.TaxID
+	}
+	if req.Country != nil {
+		updates["country"] = *req.Country
+	}
+	if req.PeppolID != nil {
+		updates["peppol_id"] = *req.PeppolID
+	}
+	if req.BankDetails != nil {
+		updates["bank_details"] = *req.BankDetails
+	}
+	if req.ReportingCurrency != nil {
+		updates["reporting_currency"] = strings.ToUpper(*req.ReportingCurrency)
+	}
+
+	profile, err := h.profileService.UpdateProfile(userID, updates)
+	if err != nil {
+		if err == services.ErrInvalidPeppolID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update company profile"})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapProfileToResponse(profile))
+}
+
+func (h *CompanyProfileHandler) UploadLogo(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "File is required",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	profile, err := h.profileService.UploadLogo(userID, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		switch err {
+		case services.ErrFileTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case services.ErrFileTypeForbidden:
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "logo must be a PNG or JPEG image"})
+		case services.ErrFileEmpty, services.ErrLogoUnreadable, services.ErrLogoTooLarge:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload logo"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapProfileToResponse(profile))
+}
+
+func (h *CompanyProfileHandler) mapProfileToResponse(profile *models.CompanyProfile) *schemas.CompanyProfileResponse {
+	return &schemas.CompanyProfileResponse{
+		Name:              profile.Name,
+		Address:           profile.Address,
+		Email:             profile.Email,
+		Phone:             profile.Phone,
+		Website:           profile.Website,
+		TaxID:             profile.TaxID,
+		Country:           profile.Country,
+		PeppolID:          profile.PeppolID,
+		BankDetails:       profile.BankDetails,
+		ReportingCurrency: profile.ReportingCurrency,
+		HasLogo:           profile.HasLogo(),
+		LogoFile:          profile.LogoFileName,
+	}
+}
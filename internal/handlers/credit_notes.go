@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CreditNoteHandler struct {
+	creditNoteService *services.CreditNoteService
+}
+
+func NewCreditNoteHandler() *CreditNoteHandler {
+	return &CreditNoteHandler{
+		creditNoteService: services.NewCreditNoteService(),
+	}
+}
+
+func (h *CreditNoteHandler) CreateCreditNote(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req schemas.CreateCreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	issueDate := time.Now()
+	if req.IssueDate != nil {
+		issueDate, _ = time.Parse("2006-01-02", *req.IssueDate)
+	}
+
+	lines := make([]services.CreditNoteLineInput, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = services.CreditNoteLineInput{
+			InvoiceLineID: line.InvoiceLineID,
+			Quantity:      line.Hours,
+		}
+		if line.Quantity > 0 {
+			lines[i].Quantity = line.Quantity
+		}
+	}
+
+	note, err := h.creditNoteService.CreateCreditNote(userID, invoiceID, issueDate, req.Reason, lines)
+	if err != nil {
+		h.handleCreditNoteError(c, err, "Failed to create credit note")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapCreditNoteToResponse(note))
+}
+
+func (h *CreditNoteHandler) ListInvoiceCreditNotes(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	notes, err := h.creditNoteService.ListInvoiceCreditNotes(userID, invoiceID)
+	if err != nil {
+		h.handleCreditNoteError(c, err, "Failed to fetch credit notes")
+		return
+	}
+
+	response := schemas.CreditNoteListResponse{
+		CreditNotes: make([]schemas.CreditNoteResponse, len(notes)),
+		Total:       int64(len(notes)),
+		Limit:       len(notes),
+	}
+	for i, note := range notes {
+		response.CreditNotes[i] = *mapCreditNoteToResponse(note)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CreditNoteHandler) ListCreditNotes(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	var clientID *uuid.UUID
+	if clientIDStr := c.Query("client_id"); clientIDStr != "" {
+		id, err := uuid.Parse(clientIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+			return
+		}
+		clientID = &id
+	}
+
+	notes, total, err := h.creditNoteService.ListCreditNotes(userID, clientID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch credit notes"})
+		return
+	}
+
+	response := schemas.CreditNoteListResponse{
+		CreditNotes: make([]schemas.CreditNoteResponse, len(notes)),
+		Total:       total,
+		Offset:      offset,
+		Limit:       limit,
+	}
+	for i, note := range notes {
+		response.CreditNotes[i] = *mapCreditNoteToResponse(note)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CreditNoteHandler) GetCreditNote(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	noteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid credit note ID"})
+		return
+	}
+
+	note, err := h.creditNoteService.GetCreditNote(userID, noteID)
+	if err != nil {
+		h.handleCreditNoteError(c, err, "Failed to fetch credit note")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapCreditNoteToResponse(note))
+}
+
+func (h *CreditNoteHandler) handleCreditNoteError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrInvoiceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+	case services.ErrCreditNoteNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Credit note not found"})
+	case services.ErrInvoiceLineNotFound, services.ErrCreditExceedsInvoice:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrInvoiceNotCreditable, services.ErrNothingToCredit:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapCreditNoteToResponse(note *models.CreditNote) *schemas.CreditNoteResponse {
+	response := &schemas.CreditNoteResponse{
+		ID:            note.ID,
+		Number:        note.Number,
+		InvoiceID:     note.InvoiceID,
+		InvoiceNumber: note.Invoice.Number,
+		ClientID:      note.ClientID,
+		IssueDate:     time.Time(note.IssueDate).Format("2006-01-02"),
+		Currency:      note.Currency,
+		Reason:        note.Reason,
+		TotalHours:    note.TotalHours,
+		Subtotal:      note.Subtotal,
+		TaxTotal:      note.TaxTotal,
+		Total:         note.Total,
+		AppliedAmount: note.AppliedAmount,
+		CreditAmount:  note.CreditAmount(),
+		Lines:         make([]schemas.CreditNoteLineResponse, len(note.Lines)),
+		CreatedAt:     note.CreatedAt,
+	}
+
+	if note.Client.ID != uuid.Nil {
+		response.Client = &schemas.ClientSummary{
+			ID:   note.Client.ID,
+			Name: note.Client.Name,
+			Code: note.Client.Code,
+		}
+	}
+
+	for i, line := range note.Lines {
+		response.Lines[i] = schemas.CreditNoteLineResponse{
+			ID:              line.ID,
+			Position:        line.Position,
+			InvoiceLineID:   line.InvoiceLineID,
+			ProjectID:       line.ProjectID,
+			Type:            string(line.Type),
+			Description:     line.Description,
+			Hours:           line.Hours,
+			Quantity:        line.Units(),
+			Rate:            line.Rate,
+			Amount:          line.Amount,
+			ReleasedEntries: line.ReleasedEntries,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ExchangeRateHandler struct {
+	rateService *services.ExchangeRateService
+}
+
+func NewExchangeRateHandler() *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		rateService: services.NewExchangeRateService(),
+	}
+}
+
+func (h *ExchangeRateHandler) SetRate(c *gin.Context) {
+	var req schemas.SetExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+
+	rate, err := h.rateService.SetRate(req.BaseCurrency, req.QuoteCurrency, req.Rate, date)
+	if err != nil {
+		if err == services.ErrSameCurrencyPair {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save exchange rate"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapExchangeRateToResponse(rate))
+}
+
+func (h *ExchangeRateHandler) ListRates(c *gin.Context) {
+	var baseCurrency, quoteCurrency *string
+	if value := c.Query("base_currency"); value != "" {
+		baseCurrency = &value
+	}
+	if value := c.Query("quote_currency"); value != "" {
+		quoteCurrency = &value
+	}
+
+	startDate, endDate, ok := parseOptionalDateRange(c)
+	if !ok {
+		return
+	}
+
+	rates, err := h.rateService.ListRates(baseCurrency, quoteCurrency, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch exchange rates"})
+		return
+	}
+
+	response := schemas.ExchangeRateListResponse{
+		Rates: make([]schemas.ExchangeRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = *mapExchangeRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ExchangeRateHandler) DeleteRate(c *gin.Context) {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.rateService.DeleteRate(rateID); err != nil {
+		if err == services.ErrExchangeRateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Exchange rate not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete exchange rate"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *ExchangeRateHandler) ImportRates(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "File is required",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	result, err := h.rateService.ImportRates(file)
+	if err != nil {
+		switch {
+		case err == services.ErrFileTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case err == services.ErrFileEmpty, errors.Is(err, services.ErrInvalidExchangeRateFile):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import exchange rates"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, schemas.ExchangeRateImportResponse{
+		Format:   string(result.Format),
+		Imported: result.Imported,
+		Updated:  result.Updated,
+	})
+}
+
+func mapExchangeRateToResponse(rate *models.ExchangeRate) *schemas.ExchangeRateResponse {
+	return &schemas.ExchangeRateResponse{
+		ID:            rate.ID,
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		Date:          time.Time(rate.Date).Format("2006-01-02"),
+		Source:        string(rate.Source),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ExpenseHandler struct {
+	expenseService *services.ExpenseService
+}
+
+func NewExpenseHandler() *ExpenseHandler {
+	return &ExpenseHandler{
+		expenseService: services.NewExpenseService(),
+	}
+}
+
+func (h *ExpenseHandler) CreateExpense(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+
+	isBillable := true
+	if req.IsBillable != nil {
+		isBillable = *req.IsBillable
+	}
+
+	expense, err := h.expenseService.CreateExpense(
+		userID, req.ProjectID, date, req.Amount, req.Currency,
+		models.ExpenseCategory(req.Category), req.Description, isBillable, req.MarkupPercent,
+	)
+	if err != nil {
+		switch err {
+		case services.ErrDateInFuture:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot record expenses for future dates"})
+		case services.ErrInvalidExpenseCategory:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			if err.Error() == "project not found or access denied" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create expense"})
+			}
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapExpenseToResponse(expense))
+}
+
+func (h *ExpenseHandler) GetExpense(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	expenseID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expense ID"})
+		return
+	}
+
+	expense, err := h.expenseService.GetExpense(userID, expenseID)
+	if err != nil {
+		if err == services.ErrExpenseNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Expense not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch expense"})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapExpenseToResponse(expense))
+}
+
+func (h *ExpenseHandler) ListExpenses(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	var filter services.ExpenseFilter
+	if projectIDStr := c.Query("project_id"); projectIDStr != "" {
+		if id, err := uuid.Parse(projectIDStr); err == nil {
+			filter.ProjectID = &id
+		}
+	}
+
+	if startStr := c.Query("start_date"); startStr != "" {
+		if date, err := time.Parse("2006-01-02", startStr); err == nil {
+			filter.StartDate = &date
+		}
+	}
+	if endStr := c.Query("end_date"); endStr != "" {
+		if date, err := time.Parse("2006-01-02", endStr); err == nil {
+			filter.EndDate = &date
+		}
+	}
+
+	if categoryStr := c.Query("category"); categoryStr != "" {
+		category := models.ExpenseCategory(categoryStr)
+		filter.Category = &category
+	}
+
+	if billableStr := c.Query("is_billable"); billableStr != "" {
+		billable := billableStr == "true"
+		filter.IsBillable = &billable
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	expenses, total, err := h.expenseService.ListExpenses(userID, filter, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch expenses"})
+		return
+	}
+
+	response := schemas.ExpenseListResponse{
+		Expenses: make([]schemas.ExpenseResponse, len(expenses)),
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	for i, expense := range expenses {
+		response.Expenses[i] = *h.mapExpenseToResponse(expense)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ExpenseHandler) UpdateExpense(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	expenseID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expense ID"})
+		return
+	}
+
+	var req schemas.UpdateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Date != nil {
+		date, _ := time.Parse("2006-01-02", *req.Date)
+		if date.After(time.Now()) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot record expenses for future dates"})
+			return
+		}
+		updates["date"] = datatypes.Date(date)
+	}
+	if req.Amount != nil {
+		updates["amount"] = *req.Amount
+	}
+	if req.Currency != nil {
+		updates["currency"] = *req.Currency
+	}
+	if req.Category != nil {
+		updates["category"] = *req.Category
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.IsBillable != nil {
+		updates["is_billable"] = *req.IsBillable
+	}
+	if req.MarkupPercent != nil {
+		updates["markup_percent"] = *req.MarkupPercent
+	}
+
+	expense, err := h.expenseService.UpdateExpense(userID, expenseID, updates)
+	if err != nil {
+		switch err {
+		case services.ErrExpenseNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Expense not found"})
+		case services.ErrInvalidExpenseCategory:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case services.ErrExpenseLocked:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update expense"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapExpenseToResponse(expense))
+}
+
+func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	expenseID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expense ID"})
+		return
+	}
+
+	if err := h.expenseService.DeleteExpense(userID, expenseID); err != nil {
+		switch err {
+		case services.ErrExpenseNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Expense not found"})
+		case services.ErrExpenseLocked:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete expense"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *ExpenseHandler) UploadReceipt(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	expenseID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expense ID"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "File is required",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	expense, err := h.expenseService.UploadReceipt(userID, expenseID, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		switch err {
+		case services.ErrExpenseNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Expense not found"})
+		case services.ErrFileTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case services.ErrFileTypeForbidden:
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		case services.ErrFileEmpty:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload receipt"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapExpenseToResponse(expense))
+}
+
+func (h *ExpenseHandler) DownloadReceipt(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	expenseID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expense ID"})
+		return
+	}
+
+	expense, reader, err := h.expenseService.OpenReceipt(userID, expenseID)
+	if err != nil {
+		switch err {
+		case services.ErrExpenseNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Expense not found"})
+		case services.ErrReceiptNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Receipt not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to download receipt"})
+		}
+		return
+	}
+	defer reader.Close()
+
+	c.DataFromReader(http.StatusOK, expense.ReceiptSize, expense.ReceiptContentType, reader, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", expense.ReceiptFileName),
+		"X-Checksum-SHA256":   expense.ReceiptChecksum,
+	})
+}
+
+func (h *ExpenseHandler) mapExpenseToResponse(expense *models.Expense) *schemas.ExpenseResponse {
+	response := &schemas.ExpenseResponse{
+		ID:             expense.ID,
+		ProjectID:      expense.ProjectID,
+		Date:           time.Time(expense.Date).Format("2006-01-02"),
+		Amount:         expense.Amount,
+		Currency:       expense.Currency,
+		Category:       string(expense.Category),
+		Description:    expense.Description,
+		IsBillable:     expense.IsBillable,
+		MarkupPercent:  expense.MarkupPercent,
+		BillableAmount: expense.BillableAmount(),
+		InvoiceID:      expense.InvoiceID,
+		IsLocked:       expense.IsLocked(),
+		CreatedAt:      expense.CreatedAt,
+		UpdatedAt:      expense.UpdatedAt,
+	}
+
+	if expense.HasReceipt() {
+		response.Receipt = &schemas.ReceiptResponse{
+			FileName:    expense.ReceiptFileName,
+			ContentType: expense.ReceiptContentType,
+			Size:        expense.ReceiptSize,
+			Checksum:    expense.ReceiptChecksum,
+		}
+	}
+
+	if expense.Project.ID != uuid.Nil {
+		response.Project = &schemas.ProjectSummary{
+			ID:           expense.Project.ID,
+			Name:         expense.Project.Name,
+			Code:         expense.Project.Code,
+			BillableRate: expense.Project.BillableRate,
+			Currency:     expense.Project.Currency,
+			Client: schemas.ClientSummary{
+				ID:   expense.Project.Client.ID,
+				Name: expense.Project.Client.Name,
+				Code: expense.Project.Client.Code,
+			},
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type HolidayHandler struct {
+	holidayService *services.HolidayService
+}
+
+func NewHolidayHandler() *HolidayHandler {
+	return &HolidayHandler{
+		holidayService: services.NewHolidayService(),
+	}
+}
+
+func (h *HolidayHandler) CreateHoliday(c *gin.Context) {
+	var req schemas.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+
+	holiday, err := h.holidayService.CreateHoliday(date, req.Name)
+	if err != nil {
+		if err == services.ErrHolidayExists {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create holiday"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapHolidayToResponse(holiday))
+}
+
+func (h *HolidayHandler) ListHolidays(c *gin.Context) {
+	var startDate, endDate *time.Time
+	if startStr := c.Query("start_date"); startStr != "" {
+		if date, err := time.Parse("2006-01-02", startStr); err == nil {
+			startDate = &date
+		}
+	}
+	if endStr := c.Query("end_date"); endStr != "" {
+		if date, err := time.Parse("2006-01-02", endStr); err == nil {
+			endDate = &date
+		}
+	}
+
+	holidays, err := h.holidayService.ListHolidays(startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch holidays"})
+		return
+	}
+
+	response := schemas.HolidayListResponse{
+		Holidays: make([]schemas.HolidayResponse, len(holidays)),
+	}
+
+	for i, holiday := range holidays {
+		response.Holidays[i] = *h.mapHolidayToResponse(holiday)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *HolidayHandler) DeleteHoliday(c *gin.Context) {
+	holidayID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid holiday ID"})
+		return
+	}
+
+	if err := h.holidayService.DeleteHoliday(holidayID); err != nil {
+		if err == services.ErrHolidayNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Holiday not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete holiday"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *HolidayHandler) mapHolidayToResponse(holiday *models.Holiday) *schemas.HolidayResponse {
+	return &schemas.HolidayResponse{
+		ID:        holiday.ID,
+		Date:      time.Time(holiday.Date).Format("2006-01-02"),
+		Name:      holiday.Name,
+		CreatedAt: holiday.CreatedAt,
+	}
+}
@@ -0,0 +1,425 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InvoiceHandler struct {
+	invoiceService  *services.InvoiceService
+	documentService *services.DocumentService
+	einvoiceService *services.EInvoiceService
+}
+
+func NewInvoiceHandler() *InvoiceHandler {
+	return &InvoiceHandler{
+		invoiceService:  services.NewInvoiceService(),
+		documentService: services.NewDocumentService(),
+		einvoiceService: services.NewEInvoiceService(),
+	}
+}
+
+func (h *InvoiceHandler) CreateInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+	if endDate.Before(startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return
+	}
+
+	grouping := models.InvoiceGrouping(req.GroupBy)
+	if grouping == "" {
+		grouping = models.InvoiceGroupingProject
+	}
+
+	invoice, err := h.invoiceService.CreateInvoice(userID, req.ClientID, startDate, endDate, grouping, req.Currency, req.Notes)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to create invoice")
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapInvoiceToResponse(invoice))
+}
+
+func (h *InvoiceHandler) GetInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	invoice, err := h.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to fetch invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapInvoiceToResponse(invoice))
+}
+
+func (h *InvoiceHandler) ListInvoices(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	var filter services.InvoiceFilter
+	if clientIDStr := c.Query("client_id"); clientIDStr != "" {
+		if id, err := uuid.Parse(clientIDStr); err == nil {
+			filter.ClientID = &id
+		}
+	}
+	if statusStr := c.Query("status"); statusStr != "" {
+		status := models.InvoiceStatus(statusStr)
+		filter.Status = &status
+	}
+
+	invoices, total, err := h.invoiceService.ListInvoices(userID, filter, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch invoices"})
+		return
+	}
+
+	response := schemas.InvoiceListResponse{
+		Invoices: make([]schemas.InvoiceResponse, len(invoices)),
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	for i, invoice := range invoices {
+		response.Invoices[i] = *h.mapInvoiceToResponse(invoice)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *InvoiceHandler) IssueInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req schemas.IssueInvoiceRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request format",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	issueDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.IssueDate != nil {
+		issueDate, _ = time.Parse("2006-01-02", *req.IssueDate)
+	}
+
+	invoice, err := h.invoiceService.IssueInvoice(userID, invoiceID, issueDate, req.PaymentTermDays)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to issue invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapInvoiceToResponse(invoice))
+}
+
+func (h *InvoiceHandler) PayInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req schemas.PayInvoiceRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request format",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	paidAt := time.Now()
+	if req.PaidDate != nil {
+		paidAt, _ = time.Parse("2006-01-02", *req.PaidDate)
+	}
+
+	invoice, err := h.invoiceService.MarkInvoicePaid(userID, invoiceID, paidAt)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to mark invoice as paid")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapInvoiceToResponse(invoice))
+}
+
+func (h *InvoiceHandler) VoidInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	invoice, err := h.invoiceService.VoidInvoice(userID, invoiceID)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to void invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapInvoiceToResponse(invoice))
+}
+
+func (h *InvoiceHandler) DeleteInvoice(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	if err := h.invoiceService.DeleteInvoice(userID, invoiceID); err != nil {
+		h.handleInvoiceError(c, err, "Failed to delete invoice")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *InvoiceHandler) DownloadInvoicePDF(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var buf bytes.Buffer
+	invoice, err := h.documentService.RenderInvoice(userID, invoiceID, &buf)
+	if err != nil {
+		h.handleInvoiceError(c, err, "Failed to render invoice")
+		return
+	}
+
+	fileName := "invoice-draft-" + invoice.ID.String()[:8] + ".pdf"
+	if invoice.Number != "" {
+		fileName = invoice.Number + ".pdf"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+func (h *InvoiceHandler) DownloadInvoiceUBL(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var buf bytes.Buffer
+	invoice, err := h.einvoiceService.ExportInvoice(userID, invoiceID, &buf)
+	if err != nil {
+		var validationErr *services.EInvoiceValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":   services.ErrEInvoiceInvalid.Error(),
+				"details": validationErr.Problems,
+			})
+		case err == services.ErrInvoiceNotExportable:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			h.handleInvoiceError(c, err, "Failed to export invoice")
+		}
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", invoice.Number+".xml"))
+	c.Data(http.StatusOK, "application/xml", buf.Bytes())
+}
+
+func (h *InvoiceHandler) handleInvoiceError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrInvoiceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+	case services.ErrClientNotFound:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "client not found or access denied"})
+	case services.ErrNothingToInvoice, services.ErrTaxRateNotFound:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case services.ErrInvoiceMixedCurrencies, services.ErrInvalidInvoiceGrouping:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrInvalidInvoiceTransition, services.ErrInvoiceHasPayments, services.ErrInvoiceHasCreditNotes:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func (h *InvoiceHandler) mapInvoiceToResponse(invoice *models.Invoice) *schemas.InvoiceResponse {
+	response := &schemas.InvoiceResponse{
+		ID:             invoice.ID,
+		Number:         invoice.Number,
+		Status:         string(invoice.Status),
+		Grouping:       string(invoice.Grouping),
+		ClientID:       invoice.ClientID,
+		Currency:       invoice.Currency,
+		PeriodStart:    time.Time(invoice.PeriodStart).Format("2006-01-02"),
+		PeriodEnd:      time.Time(invoice.PeriodEnd).Format("2006-01-02"),
+		PaidAt:         invoice.PaidAt,
+		VoidedAt:       invoice.VoidedAt,
+		TotalHours:     invoice.TotalHours,
+		Subtotal:       invoice.Subtotal,
+		TaxTotal:       invoice.TaxTotal,
+		Total:          invoice.Total,
+		AmountPaid:     invoice.AmountPaid,
+		AmountCredited: invoice.AmountCredited,
+		BalanceDue:     invoice.BalanceDue(),
+		ClientTaxID:    invoice.ClientTaxID,
+		TaxNote:        invoice.TaxNote,
+		TaxLines:       make([]schemas.InvoiceTaxLineResponse, len(invoice.TaxLines)),
+		Notes:          invoice.Notes,
+		Lines:          make([]schemas.InvoiceLineResponse, len(invoice.Lines)),
+		CreatedAt:      invoice.CreatedAt,
+		UpdatedAt:      invoice.UpdatedAt,
+	}
+
+	if invoice.IssueDate != nil {
+		issueDate := time.Time(*invoice.IssueDate).Format("2006-01-02")
+		response.IssueDate = &issueDate
+	}
+
+	if invoice.DueDate != nil {
+		dueDate := time.Time(*invoice.DueDate).Format("2006-01-02")
+		response.DueDate = &dueDate
+	}
+
+	if invoice.Client.ID != uuid.Nil {
+		response.Client = &schemas.ClientSummary{
+			ID:   invoice.Client.ID,
+			Name: invoice.Client.Name,
+			Code: invoice.Client.Code,
+		}
+	}
+
+	for i, line := range invoice.TaxLines {
+		response.TaxLines[i] = schemas.InvoiceTaxLineResponse{
+			Country:       line.Country,
+			Name:          line.Name,
+			Treatment:     string(line.Treatment),
+			Rate:          line.Rate,
+			TaxableAmount: line.TaxableAmount,
+			Amount:        line.Amount,
+		}
+	}
+
+	for i, line := range invoice.Lines {
+		response.Lines[i] = schemas.InvoiceLineResponse{
+			ID:               line.ID,
+			Position:         line.Position,
+			ProjectID:        line.ProjectID,
+			Type:             string(line.Type),
+			Description:      line.Description,
+			Hours:            line.Hours,
+			WrittenDownHours: line.WrittenDownHours,
+			Quantity:         line.Units(),
+			Unit:             line.Unit,
+			Rate:             line.Rate,
+			Amount:           line.Amount,
+		}
+
+		if line.Project.ID != uuid.Nil {
+			response.Lines[i].Project = &schemas.ProjectSummary{
+				ID:           line.Project.ID,
+				Name:         line.Project.Name,
+				Code:         line.Project.Code,
+				BillableRate: line.Project.BillableRate,
+				Currency:     line.Project.Currency,
+				Client: schemas.ClientSummary{
+					ID:   invoice.Client.ID,
+					Name: invoice.Client.Name,
+					Code: invoice.Client.Code,
+				},
+			}
+		}
+	}
+
+	return response
+}
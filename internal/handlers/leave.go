@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LeaveHandler struct {
+	leaveService *services.LeaveService
+}
+
+func NewLeaveHandler() *LeaveHandler {
+	return &LeaveHandler{
+		leaveService: services.NewLeaveService(),
+	}
+}
+
+func (h *LeaveHandler) CreateLeave(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateLeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+
+	leave, err := h.leaveService.CreateLeave(userID, startDate, endDate, models.LeaveType(req.Type), req.Notes)
+	if err != nil {
+		switch err {
+		case services.ErrInvalidLeaveRange:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case services.ErrLeaveOverlap:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create leave"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapLeaveToResponse(leave))
+}
+
+func (h *LeaveHandler) ListLeave(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseOptionalDateRange(c)
+	if !ok {
+		return
+	}
+
+	leave, err := h.leaveService.ListLeave(userID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch leave"})
+		return
+	}
+
+	response := schemas.LeaveListResponse{
+		Leave: make([]schemas.LeaveResponse, len(leave)),
+	}
+
+	for i, period := range leave {
+		response.Leave[i] = *h.mapLeaveToResponse(period)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LeaveHandler) DeleteLeave(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	leaveID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leave ID"})
+		return
+	}
+
+	if err := h.leaveService.DeleteLeave(userID, leaveID); err != nil {
+		if err == services.ErrLeaveNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Leave not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete leave"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *LeaveHandler) mapLeaveToResponse(leave *models.Leave) *schemas.LeaveResponse {
+	return &schemas.LeaveResponse{
+		ID:        leave.ID,
+		StartDate: time.Time(leave.StartDate).Format("2006-01-02"),
+		EndDate:   time.Time(leave.EndDate).Format("2006-01-02"),
+		Type:      string(leave.Type),
+		Notes:     leave.Notes,
+		CreatedAt: leave.CreatedAt,
+	}
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type MilestoneHandler struct {
+	milestoneService *services.MilestoneService
+}
+
+func NewMilestoneHandler() *MilestoneHandler {
+	return &MilestoneHandler{
+		milestoneService: services.NewMilestoneService(),
+	}
+}
+
+func (h *MilestoneHandler) CreateMilestone(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var req schemas.CreateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	milestone := &models.ProjectMilestone{
+		Name:        req.Name,
+		Amount:      req.Amount,
+		DueDate:     parseOptionalDate(req.DueDate),
+		CompletedOn: parseOptionalDate(req.CompletedOn),
+	}
+
+	milestone, err = h.milestoneService.CreateMilestone(userID, projectID, milestone)
+	if err != nil {
+		handleMilestoneError(c, err, "Failed to create milestone")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapMilestoneToResponse(milestone))
+}
+
+func (h *MilestoneHandler) ListMilestones(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	milestones, err := h.milestoneService.ListMilestones(userID, projectID)
+	if err != nil {
+		handleMilestoneError(c, err, "Failed to fetch milestones")
+		return
+	}
+
+	response := schemas.MilestoneListResponse{
+		ProjectID:  projectID,
+		Milestones: make([]schemas.MilestoneResponse, len(milestones)),
+	}
+	for i, milestone := range milestones {
+		response.Milestones[i] = *mapMilestoneToResponse(milestone)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *MilestoneHandler) UpdateMilestone(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	milestoneID, err := uuid.Parse(c.Param("milestoneId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid milestone ID"})
+		return
+	}
+
+	var req schemas.UpdateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Amount != nil {
+		updates["amount"] = *req.Amount
+	}
+	if req.DueDate != nil {
+		updates["due_date"] = parseOptionalDate(req.DueDate)
+	}
+	if req.CompletedOn != nil {
+		updates["completed_on"] = parseOptionalDate(req.CompletedOn)
+	}
+
+	milestone, err := h.milestoneService.UpdateMilestone(userID, projectID, milestoneID, updates)
+	if err != nil {
+		handleMilestoneError(c, err, "Failed to update milestone")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapMilestoneToResponse(milestone))
+}
+
+func (h *MilestoneHandler) DeleteMilestone(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	milestoneID, err := uuid.Parse(c.Param("milestoneId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid milestone ID"})
+		return
+	}
+
+	if err := h.milestoneService.DeleteMilestone(userID, projectID, milestoneID); err != nil {
+		handleMilestoneError(c, err, "Failed to delete milestone")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func handleMilestoneError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrProjectNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+	case services.ErrMilestoneNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Milestone not found"})
+	case services.ErrMilestonesNeedFixedFee:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func parseOptionalDate(value *string) *datatypes.Date {
+	if value == nil || *value == "" {
+		return nil
+	}
+	parsed, _ := time.Parse("2006-01-02", *value)
+	date := datatypes.Date(parsed)
+	return &date
+}
+
+func mapMilestoneToResponse(milestone *models.ProjectMilestone) *schemas.MilestoneResponse {
+	response := &schemas.MilestoneResponse{
+		ID:          milestone.ID,
+		ProjectID:   milestone.ProjectID,
+		Name:        milestone.Name,
+		Amount:      milestone.Amount,
+		IsCompleted: milestone.IsCompleted(),
+		CreatedAt:   milestone.CreatedAt,
+		UpdatedAt:   milestone.UpdatedAt,
+	}
+
+	if milestone.DueDate != nil {
+		dueDate := time.Time(*milestone.DueDate).Format("2006-01-02")
+		response.DueDate = &dueDate
+	}
+	if milestone.CompletedOn != nil {
+		completedOn := time.Time(*milestone.CompletedOn).Format("2006-01-02")
+		response.CompletedOn = &completedOn
+	}
+
+	return response
+}
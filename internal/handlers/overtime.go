@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OvertimeHandler struct {
+	overtimeService *services.OvertimeService
+}
+
+func NewOvertimeHandler() *OvertimeHandler {
+	return &OvertimeHandler{
+		overtimeService: services.NewOvertimeService(),
+	}
+}
+
+func (h *OvertimeHandler) CreateRule(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateOvertimeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	rule := models.DefaultOvertimeRule()
+	rule.Name = req.Name
+	if req.DailyThreshold != nil {
+		rule.DailyThreshold = *req.DailyThreshold
+	}
+	if req.WeeklyThreshold != nil {
+		rule.WeeklyThreshold = *req.WeeklyThreshold
+	}
+	if req.OvertimeMultiplier != nil {
+		rule.OvertimeMultiplier = *req.OvertimeMultiplier
+	}
+	if req.WeekendMultiplier != nil {
+		rule.WeekendMultiplier = *req.WeekendMultiplier
+	}
+	if req.HolidayMultiplier != nil {
+		rule.HolidayMultiplier = *req.HolidayMultiplier
+	}
+
+	created, err := h.overtimeService.CreateRule(userID, req.ClientID, rule)
+	if err != nil {
+		if err == services.ErrOvertimeRuleExists {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		if err.Error() == "client not found or access denied" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create overtime rule"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapOvertimeRuleToResponse(created))
+}
+
+func (h *OvertimeHandler) GetRule(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid overtime rule ID"})
+		return
+	}
+
+	rule, err := h.overtimeService.GetRule(userID, ruleID)
+	if err != nil {
+		if err == services.ErrOvertimeRuleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Overtime rule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch overtime rule"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOvertimeRuleToResponse(rule))
+}
+
+func (h *OvertimeHandler) ListRules(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	rules, err := h.overtimeService.ListRules(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch overtime rules"})
+		return
+	}
+
+	response := schemas.OvertimeRuleListResponse{
+		Rules: make([]schemas.OvertimeRuleResponse, len(rules)),
+	}
+
+	for i, rule := range rules {
+		response.Rules[i] = *mapOvertimeRuleToResponse(rule)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *OvertimeHandler) UpdateRule(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid overtime rule ID"})
+		return
+	}
+
+	var req schemas.UpdateOvertimeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.DailyThreshold != nil {
+		updates["daily_threshold"] = *req.DailyThreshold
+	}
+	if req.WeeklyThreshold != nil {
+		updates["weekly_threshold"] = *req.WeeklyThreshold
+	}
+	if req.OvertimeMultiplier != nil {
+		updates["overtime_multiplier"] = *req.OvertimeMultiplier
+	}
+	if req.WeekendMultiplier != nil {
+		updates["weekend_multiplier"] = *req.WeekendMultiplier
+	}
+	if req.HolidayMultiplier != nil {
+		updates["holiday_multiplier"] = *req.HolidayMultiplier
+	}
+	if req.IsActive != nil {
+		updates["is_active"] = *req.IsActive
+	}
+
+	rule, err := h.overtimeService.UpdateRule(userID, ruleID, updates)
+	if err != nil {
+		if err == services.ErrOvertimeRuleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Overtime rule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update overtime rule"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapOvertimeRuleToResponse(rule))
+}
+
+func (h *OvertimeHandler) DeleteRule(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid overtime rule ID"})
+		return
+	}
+
+	if err := h.overtimeService.DeleteRule(userID, ruleID); err != nil {
+		if err == services.ErrOvertimeRuleNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Overtime rule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete overtime rule"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func mapOvertimeRuleToResponse(rule *models.OvertimeRule) *schemas.OvertimeRuleResponse {
+	response := &schemas.OvertimeRuleResponse{
+		ID:                 rule.ID,
+		Name:               rule.Name,
+		ClientID:           rule.ClientID,
+		DailyThreshold:     rule.DailyThreshold,
+		WeeklyThreshold:    rule.WeeklyThreshold,
+		OvertimeMultiplier: rule.OvertimeMultiplier,
+		WeekendMultiplier:  rule.WeekendMultiplier,
+		HolidayMultiplier:  rule.HolidayMultiplier,
+		IsActive:           rule.IsActive,
+		CreatedAt:          rule.CreatedAt,
+		UpdatedAt:          rule.UpdatedAt,
+	}
+
+	if rule.Client != nil {
+		response.Client = &schemas.ClientSummary{
+			ID:   rule.Client.ID,
+			Name: rule.Client.Name,
+			Code: rule.Client.Code,
+		}
+	}
+
+	return response
+}
+
+func mapOvertimeSplitToResponse(split services.OvertimeSplit) schemas.OvertimeSplitResponse {
+	return schemas.OvertimeSplitResponse{
+		RegularHours:  split.RegularHours,
+		OvertimeHours: split.OvertimeHours,
+		WeekendHours:  split.WeekendHours,
+		HolidayHours:  split.HolidayHours,
+	}
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func parseDateRange(c *gin.Context) (time.Time, time.Time, bool) {
+	startStr := c.Query("start_date")
+	endStr := c.Query("end_date")
+	if startStr == "" || endStr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "start_date and end_date parameters are required"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	startDate, err := time.Parse("2006-01-02", startStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start_date format, use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	endDate, err := time.Parse("2006-01-02", endStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end_date format, use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	if endDate.Before(startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	return startDate, endDate, true
+}
+
+func parseOptionalDateRange(c *gin.Context) (*time.Time, *time.Time, bool) {
+	var startDate, endDate *time.Time
+	if startStr := c.Query("start_date"); startStr != "" {
+		date, err := time.Parse("2006-01-02", startStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start_date format, use YYYY-MM-DD"})
+			return nil, nil, false
+		}
+		startDate = &date
+	}
+
+	if endStr := c.Query("end_date"); endStr != "" {
+		date, err := time.Parse("2006-01-02", endStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end_date format, use YYYY-MM-DD"})
+			return nil, nil, false
+		}
+		endDate = &date
+	}
+
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return nil, nil, false
+	}
+
+	return startDate, endDate, true
+}
+
+func parseOptionalMonth(c *gin.Context) (*time.Time, bool) {
+	monthStr := c.Query("month")
+	if monthStr == "" {
+		return nil, true
+	}
+
+	month, err := time.Parse("2006-01", monthStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid month format, use YYYY-MM"})
+		return nil, false
+	}
+	return &month, true
+}
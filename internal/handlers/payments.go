@@ -0,0 +1,352 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PaymentHandler struct {
+	paymentService *services.PaymentService
+}
+
+func NewPaymentHandler() *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: services.NewPaymentService(),
+	}
+}
+
+func (h *PaymentHandler) RecordPayment(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req schemas.RecordPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	paymentDate := time.Now()
+	if req.PaymentDate != nil {
+		paymentDate, _ = time.Parse("2006-01-02", *req.PaymentDate)
+	}
+
+	payment, err := h.paymentService.RecordPayment(userID, invoiceID, services.PaymentInput{
+		Amount:    req.Amount,
+		Date:      paymentDate,
+		Method:    models.PaymentMethod(req.Method),
+		Reference: req.Reference,
+		Notes:     req.Notes,
+	})
+	if err != nil {
+		h.handlePaymentError(c, err, "Failed to record payment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapPaymentToResponse(payment))
+}
+
+func (h *PaymentHandler) ApplyCredit(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req schemas.ApplyCreditRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	paymentDate := time.Now()
+	if req.PaymentDate != nil {
+		paymentDate, _ = time.Parse("2006-01-02", *req.PaymentDate)
+	}
+
+	payment, err := h.paymentService.ApplyCredit(userID, invoiceID, req.Amount, paymentDate)
+	if err != nil {
+		h.handlePaymentError(c, err, "Failed to apply credit")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapPaymentToResponse(payment))
+}
+
+func (h *PaymentHandler) ListInvoicePayments(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	payments, err := h.paymentService.ListInvoicePayments(userID, invoiceID)
+	if err != nil {
+		h.handlePaymentError(c, err, "Failed to fetch payments")
+		return
+	}
+
+	response := schemas.PaymentListResponse{
+		Payments: make([]schemas.PaymentResponse, len(payments)),
+		Total:    int64(len(payments)),
+		Limit:    len(payments),
+	}
+	for i, payment := range payments {
+		response.Payments[i] = *mapPaymentToResponse(payment)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PaymentHandler) ListPayments(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	var clientID *uuid.UUID
+	if clientIDStr := c.Query("client_id"); clientIDStr != "" {
+		id, err := uuid.Parse(clientIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+			return
+		}
+		clientID = &id
+	}
+
+	payments, total, err := h.paymentService.ListPayments(userID, clientID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch payments"})
+		return
+	}
+
+	response := schemas.PaymentListResponse{
+		Payments: make([]schemas.PaymentResponse, len(payments)),
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}
+	for i, payment := range payments {
+		response.Payments[i] = *mapPaymentToResponse(payment)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PaymentHandler) DeletePayment(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	paymentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payment ID"})
+		return
+	}
+
+	if err := h.paymentService.DeletePayment(userID, paymentID); err != nil {
+		h.handlePaymentError(c, err, "Failed to delete payment")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *PaymentHandler) GetClientCredit(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	clientID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+		return
+	}
+
+	client, balances, err := h.paymentService.GetClientCredit(userID, clientID)
+	if err != nil {
+		if err == services.ErrClientNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Client not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch client credit"})
+		return
+	}
+
+	c.JSON(http.StatusOK, schemas.ClientCreditResponse{
+		Client: schemas.ClientSummary{
+			ID:   client.ID,
+			Name: client.Name,
+			Code: client.Code,
+		},
+		Balances: balances,
+	})
+}
+
+func (h *PaymentHandler) MatchStatement(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "File is required",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	format := services.StatementFormat{
+		DecimalSeparator: c.DefaultPostForm("decimal_separator", "."),
+		DateFormat:       c.DefaultPostForm("date_format", "yyyy-mm-dd"),
+	}
+
+	lines, err := h.paymentService.MatchStatement(userID, file, format)
+	if err != nil {
+		switch {
+		case err == services.ErrInvalidDecimalSeparator, err == services.ErrInvalidDateFormat:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case err == services.ErrFileTooLarge:
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case err == services.ErrFileEmpty, errors.Is(err, services.ErrInvalidStatementFile):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to match bank statement"})
+		}
+		return
+	}
+
+	response := schemas.StatementMatchResponse{
+		Lines: make([]schemas.StatementLineResponse, len(lines)),
+	}
+	for i, line := range lines {
+		response.Lines[i] = schemas.StatementLineResponse{
+			Line:        line.Line,
+			Date:        line.Date.Format("2006-01-02"),
+			Amount:      line.Amount,
+			Description: line.Description,
+			Matches:     make([]schemas.InvoiceMatchResponse, len(line.Matches)),
+		}
+		for j, match := range line.Matches {
+			response.Lines[i].Matches[j] = schemas.InvoiceMatchResponse{
+				InvoiceID: match.Invoice.ID,
+				Number:    match.Invoice.Number,
+				Client: schemas.ClientSummary{
+					ID:   match.Invoice.Client.ID,
+					Name: match.Invoice.Client.Name,
+					Code: match.Invoice.Client.Code,
+				},
+				Currency:   match.Invoice.Currency,
+				BalanceDue: match.Invoice.BalanceDue(),
+				Score:      match.Score,
+				Reasons:    match.Reasons,
+			}
+		}
+		if len(line.Matches) > 0 {
+			response.Matched++
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PaymentHandler) handlePaymentError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrInvoiceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+	case services.ErrPaymentNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Payment not found"})
+	case services.ErrInvalidPaymentMethod, services.ErrPaymentAmountRequired:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrInvoiceNotPayable, services.ErrCreditAlreadyUsed, services.ErrInvoiceHasCreditNotes:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case services.ErrNoCreditAvailable:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapPaymentToResponse(payment *models.Payment) *schemas.PaymentResponse {
+	response := &schemas.PaymentResponse{
+		ID:            payment.ID,
+		InvoiceID:     payment.InvoiceID,
+		InvoiceNumber: payment.Invoice.Number,
+		ClientID:      payment.ClientID,
+		PaymentDate:   time.Time(payment.PaymentDate).Format("2006-01-02"),
+		Currency:      payment.Currency,
+		Amount:        payment.Amount,
+		AppliedAmount: payment.AppliedAmount,
+		CreditAmount:  payment.CreditAmount(),
+		Method:        string(payment.Method),
+		Reference:     payment.Reference,
+		Notes:         payment.Notes,
+		CreatedAt:     payment.CreatedAt,
+	}
+
+	if payment.Client.ID != uuid.Nil {
+		response.Client = &schemas.ClientSummary{
+			ID:   payment.Client.ID,
+			Name: payment.Client.Name,
+			Code: payment.Client.Code,
+		}
+	}
+
+	return response
+}
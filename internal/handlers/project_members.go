@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ProjectMemberHandler struct {
+	memberService *services.ProjectMemberService
+}
+
+func NewProjectMemberHandler() *ProjectMemberHandler {
+	return &ProjectMemberHandler{
+		memberService: services.NewProjectMemberService(),
+	}
+}
+
+func (h *ProjectMemberHandler) ListMembers(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	members, err := h.memberService.ListMembers(userID, projectID)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to fetch project members")
+		return
+	}
+
+	response := schemas.ProjectMemberListResponse{
+		ProjectID: projectID,
+		Members:   make([]schemas.ProjectMemberResponse, len(members)),
+	}
+	for i, member := range members {
+		response.Members[i] = *mapProjectMemberToResponse(member)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProjectMemberHandler) AddMember(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var req schemas.AddProjectMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	member, err := h.memberService.AddMember(userID, projectID, req.Username, req.Email)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to add project member")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapProjectMemberToResponse(member))
+}
+
+func (h *ProjectMemberHandler) RemoveMember(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := h.memberService.RemoveMember(userID, projectID, memberID); err != nil {
+		handleProjectRateError(c, err, "Failed to remove project member")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func mapProjectMemberToResponse(member *models.ProjectMember) *schemas.ProjectMemberResponse {
+	return &schemas.ProjectMemberResponse{
+		UserID:    member.UserID,
+		Username:  member.User.Username,
+		FullName:  member.User.FullName,
+		CreatedAt: member.CreatedAt,
+	}
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ProjectRateHandler struct {
+	rateService *services.ProjectRateService
+}
+
+func NewProjectRateHandler() *ProjectRateHandler {
+	return &ProjectRateHandler{
+		rateService: services.NewProjectRateService(),
+	}
+}
+
+func (h *ProjectRateHandler) ListRates(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	project, rates, err := h.rateService.ListRates(userID, projectID)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to fetch project rates")
+		return
+	}
+
+	response := schemas.ProjectRateListResponse{
+		ProjectID:   project.ID,
+		Currency:    project.Currency,
+		CurrentRate: project.BillableRate,
+		Rates:       make([]schemas.ProjectRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = *mapProjectRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProjectRateHandler) SetRate(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var req schemas.SetProjectRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	effectiveFrom, _ := time.Parse("2006-01-02", req.EffectiveFrom)
+
+	rate, err := h.rateService.SetRate(userID, projectID, *req.Rate, effectiveFrom)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to set project rate")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapProjectRateToResponse(rate))
+}
+
+func (h *ProjectRateHandler) DeleteRate(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	rateID, err := uuid.Parse(c.Param("rateId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.rateService.DeleteRate(userID, projectID, rateID); err != nil {
+		handleProjectRateError(c, err, "Failed to delete project rate")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *ProjectRateHandler) ListMemberRates(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	rates, err := h.rateService.ListMemberRates(userID, projectID)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to fetch member rates")
+		return
+	}
+
+	response := schemas.MemberRateListResponse{
+		ProjectID: projectID,
+		Rates:     make([]schemas.MemberRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = *mapMemberRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProjectRateHandler) SetMemberRate(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var req schemas.SetMemberRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	effectiveFrom, _ := time.Parse("2006-01-02", req.EffectiveFrom)
+
+	rate, err := h.rateService.SetMemberRate(userID, projectID, req.UserID, *req.Rate, effectiveFrom)
+	if err != nil {
+		handleProjectRateError(c, err, "Failed to set member rate")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapMemberRateToResponse(rate))
+}
+
+func (h *ProjectRateHandler) DeleteMemberRate(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	rateID, err := uuid.Parse(c.Param("rateId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.rateService.DeleteMemberRate(userID, projectID, rateID); err != nil {
+		handleProjectRateError(c, err, "Failed to delete member rate")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func handleProjectRateError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrProjectNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+	case services.ErrProjectRateNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project rate not found"})
+	case services.ErrMemberNotFound, services.ErrProjectMemberNotFound:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case services.ErrProjectMemberExists:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case services.ErrLastProjectRate:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapProjectRateToResponse(rate *models.ProjectRate) *schemas.ProjectRateResponse {
+	return &schemas.ProjectRateResponse{
+		ID:            rate.ID,
+		Rate:          rate.Rate,
+		EffectiveFrom: time.Time(rate.EffectiveFrom).Format("2006-01-02"),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
+
+func mapMemberRateToResponse(rate *models.ProjectMemberRate) *schemas.MemberRateResponse {
+	return &schemas.MemberRateResponse{
+		ID:            rate.ID,
+		UserID:        rate.UserID,
+		Username:      rate.User.Username,
+		Rate:          rate.Rate,
+		EffectiveFrom: time.Time(rate.EffectiveFrom).Format("2006-01-02"),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
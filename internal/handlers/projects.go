@@ -49,13 +49,17 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 
 	project, err := h.projectService.CreateProject(
 		userID, req.ClientID, req.Name, req.Code, req.Description,
-		req.BillableRate, req.Currency, startDate, endDate,
+		req.BillableRate, req.Currency, startDate, endDate, mapBillingTerms(req.Billing),
 	)
 	if err != nil {
 		if err == services.ErrProjectCodeExists {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
+		if err == services.ErrInvalidBillingType || err == services.ErrInvalidBillingTerms {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		if err.Error() == "client not found or access denied" {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
@@ -184,6 +188,10 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	}
 	if req.BillableRate != nil {
 		updates["billable_rate"] = *req.BillableRate
+		if req.RateEffectiveFrom != nil {
+			effectiveFrom, _ := time.Parse("2006-01-02", *req.RateEffectiveFrom)
+			updates["rate_effective_from"] = effectiveFrom
+		}
 	}
 	if req.Currency != nil {
 		updates["currency"] = *req.Currency
@@ -203,6 +211,9 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	if req.IsActive != nil {
 		updates["is_active"] = *req.IsActive
 	}
+	if req.Billing != nil {
+		updates["billing"] = mapBillingTerms(req.Billing)
+	}
 
 	project, err := h.projectService.UpdateProject(userID, projectID, updates)
 	if err != nil {
@@ -214,7 +225,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
-		if err == services.ErrInvalidProjectStatus {
+		if err == services.ErrInvalidProjectStatus || err == services.ErrInvalidBillingType || err == services.ErrInvalidBillingTerms {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
@@ -250,6 +261,97 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h *ProjectHandler) ListDeletedProjects(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	projects, total, err := h.projectService.ListDeletedProjects(userID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch deleted projects"})
+		return
+	}
+
+	response := schemas.ProjectListResponse{
+		Projects: make([]schemas.ProjectResponse, len(projects)),
+		Total:    total,
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	for i, project := range projects {
+		response.Projects[i] = *h.mapProjectToResponse(project)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProjectHandler) RestoreProject(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	project, err := h.projectService.RestoreProject(userID, projectID)
+	if err != nil {
+		switch err {
+		case services.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found in trash"})
+		case services.ErrProjectCodeExists, services.ErrClientDeleted:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore project"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapProjectToResponse(project))
+}
+
+func (h *ProjectHandler) PurgeProject(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	if err := h.projectService.PurgeProject(userID, projectID); err != nil {
+		switch err {
+		case services.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found in trash"})
+		case services.ErrProjectHasRecords:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to purge project"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h *ProjectHandler) mapProjectToResponse(project *models.Project) *schemas.ProjectResponse {
 	response := &schemas.ProjectResponse{
 		ID:           project.ID,
@@ -259,6 +361,7 @@ func (h *ProjectHandler) mapProjectToResponse(project *models.Project) *schemas.
 		Status:       string(project.Status),
 		BillableRate: project.BillableRate,
 		Currency:     project.Currency,
+		Billing:      mapBillingTermsToResponse(project),
 		StartDate:    time.Time(project.StartDate).Format("2006-01-02"),
 		IsActive:     project.IsActive,
 		ClientID:     project.ClientID,
@@ -266,6 +369,11 @@ func (h *ProjectHandler) mapProjectToResponse(project *models.Project) *schemas.
 		UpdatedAt:    project.UpdatedAt,
 	}
 
+	if project.DeletedAt.Valid {
+		deletedAt := project.DeletedAt.Time
+		response.DeletedAt = &deletedAt
+	}
+
 	if project.EndDate != nil {
 		endDateStr := time.Time(*project.EndDate).Format("2006-01-02")
 		response.EndDate = &endDateStr
@@ -283,3 +391,33 @@ func (h *ProjectHandler) mapProjectToResponse(project *models.Project) *schemas.
 
 	return response
 }
+
+func mapBillingTerms(req *schemas.BillingTermsRequest) services.BillingTerms {
+	if req == nil {
+		return services.BillingTerms{}
+	}
+	return services.BillingTerms{
+		Type:           models.BillingType(req.Type),
+		FixedFee:       req.FixedFee,
+		RetainerFee:    req.RetainerFee,
+		RetainerHours:  req.RetainerHours,
+		OverageRate:    req.OverageRate,
+		RolloverPolicy: models.RolloverPolicy(req.RolloverPolicy),
+		RolloverCap:    req.RolloverCap,
+	}
+}
+
+func mapBillingTermsToResponse(project *models.Project) schemas.BillingTermsResponse {
+	response := schemas.BillingTermsResponse{Type: string(project.BillingType)}
+	switch project.BillingType {
+	case models.BillingTypeFixedFee:
+		response.FixedFee = project.FixedFee
+	case models.BillingTypeRetainer:
+		response.RetainerFee = project.RetainerFee
+		response.RetainerHours = project.RetainerHours
+		response.OverageRate = project.OverageRate
+		response.RolloverPolicy = string(project.RolloverPolicy)
+		response.RolloverCap = project.RolloverCap
+	}
+	return response
+}
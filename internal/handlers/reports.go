@@ -0,0 +1,914 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	reportService      *services.ReportService
+	documentService    *services.DocumentService
+	consumptionService *services.ConsumptionService
+	taxService         *services.TaxService
+	paymentService     *services.PaymentService
+	realizationService *services.RealizationService
+	utilizationService *services.UtilizationService
+	revenueService     *services.RevenueService
+	forecastService    *services.ForecastService
+}
+
+func NewReportHandler() *ReportHandler {
+	return &ReportHandler{
+		reportService:      services.NewReportService(),
+		documentService:    services.NewDocumentService(),
+		consumptionService: services.NewConsumptionService(),
+		taxService:         services.NewTaxService(),
+		paymentService:     services.NewPaymentService(),
+		realizationService: services.NewRealizationService(),
+		utilizationService: services.NewUtilizationService(),
+		revenueService:     services.NewRevenueService(),
+		forecastService:    services.NewForecastService(),
+	}
+}
+
+func (h *ReportHandler) GetMissingTimesheets(c *gin.Context) {
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	tolerance, _ := strconv.ParseFloat(c.DefaultQuery("tolerance", "0"), 64)
+
+	gaps, rangeStart, rangeEnd, err := h.reportService.GetMissingTimesheets(startDate, endDate, tolerance)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build missing timesheet report"})
+		return
+	}
+
+	response := schemas.MissingTimesheetReportResponse{
+		StartDate: rangeStart.Format("2006-01-02"),
+		EndDate:   rangeEnd.Format("2006-01-02"),
+		Users:     make([]schemas.MissingTimesheetUser, len(gaps)),
+	}
+
+	for i, gap := range gaps {
+		user := schemas.MissingTimesheetUser{
+			UserID:        gap.User.ID,
+			Username:      gap.User.Username,
+			FullName:      gap.User.FullName,
+			ExpectedHours: gap.ExpectedHours,
+			LoggedHours:   gap.LoggedHours,
+			MissingHours:  gap.ExpectedHours - gap.LoggedHours,
+			Weeks:         make([]schemas.MissingTimesheetWeek, len(gap.Weeks)),
+		}
+
+		for j, week := range gap.Weeks {
+			weekResponse := schemas.MissingTimesheetWeek{
+				WeekStarting:  week.WeekStarting.Format("2006-01-02"),
+				ExpectedHours: week.ExpectedHours,
+				LoggedHours:   week.LoggedHours,
+				Days:          make([]schemas.MissingTimesheetDay, len(week.Days)),
+			}
+
+			for k, day := range week.Days {
+				status := "short"
+				if day.LoggedHours == 0 {
+					status = "missing"
+				}
+				weekResponse.Days[k] = schemas.MissingTimesheetDay{
+					Date:          day.Date.Format("2006-01-02"),
+					Status:        status,
+					ExpectedHours: day.ExpectedHours,
+					LoggedHours:   day.LoggedHours,
+					MissingHours:  day.MissingHours(),
+				}
+			}
+
+			user.Weeks[j] = weekResponse
+			user.MissingDays += len(week.Days)
+		}
+
+		response.Users[i] = user
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) GetProjectReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	startDate, endDate, ok := parseOptionalDateRange(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.GetProjectReport(userID, projectID, startDate, endDate, c.Query("currency"))
+	if err != nil {
+		if err == services.ErrProjectNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build project report"})
+		return
+	}
+
+	response := h.mapProjectReportToResponse(report)
+	response.StartDate = formatOptionalDate(startDate)
+	response.EndDate = formatOptionalDate(endDate)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) GetClientReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	clientID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+		return
+	}
+
+	startDate, endDate, ok := parseOptionalDateRange(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.GetClientReport(userID, clientID, startDate, endDate, c.Query("currency"))
+	if err != nil {
+		if err == services.ErrClientNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Client not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build client report"})
+		return
+	}
+
+	response := schemas.ClientReportResponse{
+		Client: schemas.ClientSummary{
+			ID:   report.Client.ID,
+			Name: report.Client.Name,
+			Code: report.Client.Code,
+		},
+		StartDate:        formatOptionalDate(startDate),
+		EndDate:          formatOptionalDate(endDate),
+		TotalHours:       report.TotalHours,
+		BillableHours:    report.BillableHours,
+		WrittenDownHours: report.WrittenDownHours,
+		BillableAmounts:  report.BillableAmounts,
+		Expenses:         h.mapExpenseTotalsToResponse(report.Expenses, report.Converted != nil),
+		TravelAmounts:    report.TravelAmounts,
+		Converted:        mapConvertedTotalsToResponse(report.Converted),
+		Projects:         make([]schemas.ProjectReportResponse, len(report.Projects)),
+	}
+
+	for i, project := range report.Projects {
+		response.Projects[i] = *h.mapProjectReportToResponse(project)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) DownloadClientTimesheet(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	clientID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	client, err := h.documentService.RenderClientTimesheet(userID, clientID, startDate, endDate, &buf)
+	if err != nil {
+		if err == services.ErrClientNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Client not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render timesheet"})
+		return
+	}
+
+	fileName := fmt.Sprintf("timesheet-%s-%s-%s.pdf", client.Code, startDate.Format("20060102"), endDate.Format("20060102"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+func (h *ReportHandler) GetProjectConsumption(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	asOf := time.Now().UTC().Truncate(24 * time.Hour)
+	if value := c.Query("as_of"); value != "" {
+		asOf, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid as_of date format. Use YYYY-MM-DD"})
+			return
+		}
+	}
+
+	consumption, err := h.consumptionService.GetProjectConsumption(userID, projectID, asOf)
+	if err != nil {
+		if err == services.ErrProjectNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build consumption report"})
+		return
+	}
+
+	project := consumption.Project
+	response := schemas.ProjectConsumptionResponse{
+		Project: schemas.ProjectSummary{
+			ID:           project.ID,
+			Name:         project.Name,
+			Code:         project.Code,
+			BillableRate: project.BillableRate,
+			Currency:     project.Currency,
+			Client: schemas.ClientSummary{
+				ID:   project.Client.ID,
+				Name: project.Client.Name,
+				Code: project.Client.Code,
+			},
+		},
+		BillingType:   string(project.BillingType),
+		AsOf:          consumption.AsOf.Format("2006-01-02"),
+		LoggedHours:   consumption.LoggedHours,
+		BillableHours: consumption.BillableHours,
+		HourlyValue:   consumption.HourlyValue,
+	}
+
+	if fixedFee := consumption.FixedFee; fixedFee != nil {
+		response.FixedFee = &schemas.FixedFeeConsumptionResponse{
+			Fee:             fixedFee.Fee,
+			ConsumedPercent: fixedFee.ConsumedPercent,
+			RemainingValue:  fixedFee.RemainingValue,
+			EffectiveRate:   fixedFee.EffectiveRate,
+			CompletedAmount: fixedFee.CompletedAmount,
+			Milestones:      make([]schemas.MilestoneResponse, len(fixedFee.Milestones)),
+		}
+		for i, milestone := range fixedFee.Milestones {
+			response.FixedFee.Milestones[i] = *mapMilestoneToResponse(milestone)
+		}
+	}
+
+	if retainer := consumption.Retainer; retainer != nil {
+		response.Retainer = &schemas.RetainerConsumptionResponse{
+			MonthlyFee:   retainer.MonthlyFee,
+			TotalFees:    retainer.TotalFees,
+			TotalOverage: retainer.TotalOverage,
+			Periods:      make([]schemas.RetainerPeriodResponse, len(retainer.Periods)),
+		}
+		for i, period := range retainer.Periods {
+			response.Retainer.Periods[i] = schemas.RetainerPeriodResponse{
+				Month:          period.Month.Format("2006-01"),
+				IncludedHours:  period.IncludedHours,
+				RolledOver:     period.RolledOver,
+				AvailableHours: period.AvailableHours,
+				UsedHours:      period.UsedHours,
+				OverageHours:   period.OverageHours,
+				OverageAmount:  period.OverageAmount,
+				CarriedForward: period.CarriedForward,
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) GetTaxReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	totals, err := h.taxService.GetTaxReport(userID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build tax report"})
+		return
+	}
+
+	response := schemas.TaxReportResponse{
+		StartDate:     startDate.Format("2006-01-02"),
+		EndDate:       endDate.Format("2006-01-02"),
+		Jurisdictions: make([]schemas.TaxJurisdictionResponse, len(totals)),
+		TaxAmounts:    make(map[string]float64),
+	}
+	for i, total := range totals {
+		response.Jurisdictions[i] = schemas.TaxJurisdictionResponse{
+			Country:       total.Country,
+			Treatment:     string(total.Treatment),
+			Currency:      total.Currency,
+			Rate:          total.Rate,
+			InvoiceCount:  total.InvoiceCount,
+			TaxableAmount: total.TaxableAmount,
+			TaxAmount:     total.TaxAmount,
+		}
+		response.TaxAmounts[total.Currency] += total.TaxAmount
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) GetAgingReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	asOf := time.Now()
+	if asOfStr := c.Query("as_of"); asOfStr != "" {
+		asOf, err = time.Parse("2006-01-02", asOfStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid as_of date format. Use YYYY-MM-DD"})
+			return
+		}
+	}
+
+	rows, totals, err := h.paymentService.GetAgingReport(userID, asOf)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build aging report"})
+		return
+	}
+
+	response := schemas.AgingReportResponse{
+		AsOf:    asOf.Format("2006-01-02"),
+		Clients: make([]schemas.ClientAgingResponse, len(rows)),
+		Totals:  make(map[string]schemas.AgingBucketsResponse, len(totals)),
+	}
+	for i, row := range rows {
+		response.Clients[i] = schemas.ClientAgingResponse{
+			Client: schemas.ClientSummary{
+				ID:   row.Client.ID,
+				Name: row.Client.Name,
+				Code: row.Client.Code,
+			},
+			Currency:     row.Currency,
+			InvoiceCount: row.InvoiceCount,
+			Buckets:      schemas.AgingBucketsResponse(row.Buckets),
+			Credit:       row.Credit,
+		}
+	}
+	for currency, buckets := range totals {
+		response.Totals[currency] = schemas.AgingBucketsResponse(*buckets)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ReportHandler) GetRealizationReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.realizationService.GetRealizationReport(userID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build realization report"})
+		return
+	}
+
+	response := schemas.RealizationReportResponse{
+		StartDate:        report.StartDate.Format("2006-01-02"),
+		EndDate:          report.EndDate.Format("2006-01-02"),
+		Projects:         make([]schemas.ProjectRealizationResponse, len(report.Projects)),
+		Consultants:      make([]schemas.ConsultantRealizationResponse, len(report.Consultants)),
+		WriteDownReasons: make(map[string]float64, len(report.ByReason)),
+	}
+	for i, row := range report.Projects {
+		response.Projects[i] = schemas.ProjectRealizationResponse{
+			Project: schemas.ProjectSummary{
+				ID:           row.Project.ID,
+				Name:         row.Project.Name,
+				Code:         row.Project.Code,
+				BillableRate: row.Project.BillableRate,
+				Currency:     row.Project.Currency,
+				Client: schemas.ClientSummary{
+					ID:   row.Project.Client.ID,
+					Name: row.Project.Client.Name,
+					Code: row.Project.Client.Code,
+				},
+			},
+			Currency:                  row.Project.Currency,
+			RealizationTotalsResponse: mapRealizationTotals(&row.RealizationTotals),
+		}
+	}
+	for i, row := range report.Consultants {
+		response.Consultants[i] = schemas.ConsultantRealizationResponse{
+			Consultant: schemas.ConsultantSummary{
+				ID:       row.User.ID,
+				Username: row.User.Username,
+				FullName: row.User.FullName,
+			},
+			Currency:                  row.Currency,
+			RealizationTotalsResponse: mapRealizationTotals(&row.RealizationTotals),
+		}
+	}
+	for reason, hours := range report.ByReason {
+		response.WriteDownReasons[string(reason)] = math.Round(hours*100) / 100
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func mapRealizationTotals(totals *services.RealizationTotals) schemas.RealizationTotalsResponse {
+	return schemas.RealizationTotalsResponse{
+		LoggedHours:      totals.LoggedHours,
+		LoggedValue:      math.Round(totals.LoggedValue*100) / 100,
+		WrittenDownHours: math.Round(totals.WrittenDownHours*100) / 100,
+		WrittenDownValue: math.Round(totals.WrittenDownValue*100) / 100,
+		BilledHours:      math.Round(totals.BilledHours*100) / 100,
+		BilledValue:      math.Round(totals.BilledValue*100) / 100,
+		UnbilledHours:    math.Round(totals.UnbilledHours*100) / 100,
+		UnbilledValue:    math.Round(totals.UnbilledValue*100) / 100,
+		RealizationRate:  totals.Realization(),
+	}
+}
+
+func (h *ReportHandler) GetUtilizationReport(c *gin.Context) {
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	var target *float64
+	if targetStr := c.Query("target"); targetStr != "" {
+		value, err := strconv.ParseFloat(targetStr, 64)
+		if err != nil || value < 0 || value > 100 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid target, use a percentage between 0 and 100"})
+			return
+		}
+		target = &value
+	}
+
+	period := services.UtilizationPeriod(c.DefaultQuery("period", string(services.UtilizationPeriodWeek)))
+	report, err := h.utilizationService.GetUtilizationReport(startDate, endDate, period, target)
+	if err != nil {
+		if err == services.ErrInvalidUtilizationPeriod {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build utilization report"})
+		return
+	}
+
+	response := schemas.UtilizationReportResponse{
+		StartDate:   report.StartDate.Format("2006-01-02"),
+		EndDate:     report.EndDate.Format("2006-01-02"),
+		Period:      string(report.Period),
+		Consultants: make([]schemas.ConsultantUtilizationResponse, len(report.Users)),
+		Totals:      mapUtilizationTotals(&report.Totals, report.Totals.Target()),
+	}
+	for i, row := range report.Users {
+		consultant := schemas.ConsultantUtilizationResponse{
+			Consultant: schemas.ConsultantSummary{
+				ID:       row.User.ID,
+				Username: row.User.Username,
+				FullName: row.User.FullName,
+			},
+			Periods:                   make([]schemas.UtilizationPeriodResponse, len(row.Periods)),
+			UtilizationTotalsResponse: mapUtilizationTotals(&row.UtilizationTotals, row.Target),
+		}
+		for j, bucket := range row.Periods {
+			consultant.Periods[j] = schemas.UtilizationPeriodResponse{
+				StartDate:                 bucket.StartDate.Format("2006-01-02"),
+				EndDate:                   bucket.EndDate.Format("2006-01-02"),
+				UtilizationTotalsResponse: mapUtilizationTotals(&bucket.UtilizationTotals, row.Target),
+			}
+		}
+		response.Consultants[i] = consultant
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func mapUtilizationTotals(totals *services.UtilizationTotals, target float64) schemas.UtilizationTotalsResponse {
+	response := schemas.UtilizationTotalsResponse{
+		CapacityHours:       math.Round(totals.CapacityHours*100) / 100,
+		HolidayHours:        math.Round(totals.HolidayHours*100) / 100,
+		LeaveHours:          math.Round(totals.LeaveHours*100) / 100,
+		AvailableHours:      math.Round(totals.AvailableHours()*100) / 100,
+		TotalHours:          totals.TotalHours,
+		BillableHours:       totals.BillableHours,
+		NonBillableHours:    totals.NonBillableHours,
+		Utilization:         totals.Utilization(),
+		BillableUtilization: totals.BillableUtilization(),
+		Target:              target,
+		TargetHours:         math.Round(totals.TargetHours*100) / 100,
+	}
+	if totals.AvailableHours() > 0 {
+		response.TargetVariance = math.Round((totals.BillableUtilization()-target)*10) / 10
+	}
+	return response
+}
+
+func (h *ReportHandler) GetRevenueReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	var groupBy []services.RevenueGrouping
+	for _, grouping := range strings.Split(c.DefaultQuery("group_by", string(services.RevenueGroupingClient)), ",") {
+		groupBy = append(groupBy, services.RevenueGrouping(strings.TrimSpace(grouping)))
+	}
+	period := services.RevenuePeriod(c.DefaultQuery("period", string(services.RevenuePeriodMonth)))
+
+	report, err := h.revenueService.GetRevenueReport(userID, startDate, endDate, groupBy, period, c.Query("currency"))
+	if err != nil {
+		switch err {
+		case services.ErrInvalidRevenueGrouping, services.ErrInvalidRevenuePeriod:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build revenue report"})
+		}
+		return
+	}
+
+	groupings := make([]string, len(report.GroupBy))
+	for i, grouping := range report.GroupBy {
+		groupings[i] = string(grouping)
+	}
+
+	converted := report.Converted != nil
+	response := schemas.RevenueReportResponse{
+		StartDate:         report.StartDate.Format("2006-01-02"),
+		EndDate:           report.EndDate.Format("2006-01-02"),
+		PreviousStartDate: report.PreviousStartDate.Format("2006-01-02"),
+		PreviousEndDate:   report.PreviousEndDate.Format("2006-01-02"),
+		GroupBy:           strings.Join(groupings, ","),
+		Period:            string(report.Period),
+		Rows:              make([]schemas.RevenueRowResponse, len(report.Rows)),
+		Periods:           make([]schemas.RevenuePeriodTotalResponse, len(report.Periods)),
+		Totals:            make([]schemas.RevenueTotalResponse, len(report.Totals)),
+	}
+
+	for i, row := range report.Rows {
+		rowResponse := schemas.RevenueRowResponse{
+			Currency:                  row.Currency,
+			Periods:                   make([]schemas.RevenuePeriodResponse, len(row.Periods)),
+			RevenueComparisonResponse: mapRevenueComparison(row.Hours, row.Amount, row.PreviousHours, row.PreviousAmount),
+		}
+		if row.Client != nil {
+			rowResponse.Client = &schemas.ClientSummary{
+				ID:   row.Client.ID,
+				Name: row.Client.Name,
+				Code: row.Client.Code,
+			}
+		}
+		if row.Project != nil {
+			rowResponse.Project = &schemas.ProjectSummary{
+				ID:           row.Project.ID,
+				Name:         row.Project.Name,
+				Code:         row.Project.Code,
+				BillableRate: row.Project.BillableRate,
+				Currency:     row.Project.Currency,
+				Client: schemas.ClientSummary{
+					ID:   row.Project.Client.ID,
+					Name: row.Project.Client.Name,
+					Code: row.Project.Client.Code,
+				},
+			}
+		}
+		if row.User != nil {
+			rowResponse.Consultant = &schemas.ConsultantSummary{
+				ID:       row.User.ID,
+				Username: row.User.Username,
+				FullName: row.User.FullName,
+			}
+		}
+		if converted {
+			comparison := mapRevenueComparison(row.Hours, row.ConvertedAmount, row.PreviousHours, row.ConvertedPreviousAmount)
+			rowResponse.Converted = &comparison
+		}
+		for j, bucket := range row.Periods {
+			rowResponse.Periods[j] = schemas.RevenuePeriodResponse{
+				StartDate: bucket.StartDate.Format("2006-01-02"),
+				EndDate:   bucket.EndDate.Format("2006-01-02"),
+				Hours:     bucket.Hours,
+				Amount:    math.Round(bucket.Amount*100) / 100,
+			}
+			if converted {
+				amount := math.Round(bucket.ConvertedAmount*100) / 100
+				rowResponse.Periods[j].ConvertedAmount = &amount
+			}
+		}
+		response.Rows[i] = rowResponse
+	}
+
+	for i, bucket := range report.Periods {
+		amounts := make(map[string]float64, len(bucket.Amounts))
+		for currency, amount := range bucket.Amounts {
+			amounts[currency] = math.Round(amount*100) / 100
+		}
+		response.Periods[i] = schemas.RevenuePeriodTotalResponse{
+			StartDate: bucket.StartDate.Format("2006-01-02"),
+			EndDate:   bucket.EndDate.Format("2006-01-02"),
+			Hours:     bucket.Hours,
+			Amounts:   amounts,
+		}
+		if converted {
+			amount := math.Round(bucket.ConvertedAmount*100) / 100
+			response.Periods[i].ConvertedAmount = &amount
+		}
+	}
+
+	var hours, previousHours float64
+	for i, total := range report.Totals {
+		hours += total.Hours
+		previousHours += total.PreviousHours
+		response.Totals[i] = schemas.RevenueTotalResponse{
+			Currency:                  total.Currency,
+			RevenueComparisonResponse: mapRevenueComparison(total.Hours, total.Amount, total.PreviousHours, total.PreviousAmount),
+		}
+	}
+
+	if converted {
+		missingRates := report.Converted.MissingRates
+		if missingRates == nil {
+			missingRates = []string{}
+		}
+		response.Converted = &schemas.ConvertedRevenueResponse{
+			Currency:                  report.Converted.Currency,
+			MissingRates:              missingRates,
+			RevenueComparisonResponse: mapRevenueComparison(hours, report.Converted.BillableAmount, previousHours, report.ConvertedPrevious),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func mapRevenueComparison(hours, amount, previousHours, previousAmount float64) schemas.RevenueComparisonResponse {
+	amount = math.Round(amount*100) / 100
+	previousAmount = math.Round(previousAmount*100) / 100
+	comparison := schemas.RevenueComparisonResponse{
+		Hours:          hours,
+		Amount:         amount,
+		PreviousHours:  previousHours,
+		PreviousAmount: previousAmount,
+		Change:         math.Round((amount-previousAmount)*100) / 100,
+	}
+	if previousAmount != 0 {
+		percent := math.Round((amount-previousAmount)/previousAmount*1000) / 10
+		comparison.ChangePercent = &percent
+	}
+	return comparison
+}
+
+func (h *ReportHandler) GetRevenueForecast(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	weeks, err := strconv.Atoi(c.DefaultQuery("weeks", "8"))
+	if err != nil || weeks < 1 || weeks > 52 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "weeks must be between 1 and 52"})
+		return
+	}
+
+	pastWeeks, err := strconv.Atoi(c.DefaultQuery("past_weeks", "4"))
+	if err != nil || pastWeeks < 0 || pastWeeks > 52 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "past_weeks must be between 0 and 52"})
+		return
+	}
+
+	var asOf *time.Time
+	if value := c.Query("as_of"); value != "" {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid as_of date format. Use YYYY-MM-DD"})
+			return
+		}
+		asOf = &date
+	}
+
+	forecast, err := h.forecastService.GetRevenueForecast(userID, asOf, weeks, pastWeeks, c.Query("currency"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build revenue forecast"})
+		return
+	}
+
+	response := schemas.RevenueForecastResponse{
+		AsOf:      forecast.AsOf.Format("2006-01-02"),
+		TimeZone:  forecast.TimeZone,
+		StartDate: forecast.StartDate.Format("2006-01-02"),
+		EndDate:   forecast.EndDate.Format("2006-01-02"),
+		Weeks:     make([]schemas.ForecastWeekResponse, len(forecast.Weeks)),
+		Upcoming:  mapForecastTotals(forecast.Upcoming, false),
+		Past:      mapForecastTotals(forecast.Past, true),
+	}
+
+	for i, week := range forecast.Weeks {
+		past := week.Status == services.ForecastWeekPast
+		weekResponse := schemas.ForecastWeekResponse{
+			WeekStarting: week.WeekStarting.Format("2006-01-02"),
+			Status:       string(week.Status),
+			Projects:     make([]schemas.ForecastLineResponse, len(week.Lines)),
+			Totals:       mapForecastTotals(week.Totals, past),
+		}
+		for j, line := range week.Lines {
+			weekResponse.Projects[j] = schemas.ForecastLineResponse{
+				Project: schemas.ProjectSummary{
+					ID:           line.Project.ID,
+					Name:         line.Project.Name,
+					Code:         line.Project.Code,
+					BillableRate: line.Project.BillableRate,
+					Currency:     line.Project.Currency,
+					Client: schemas.ClientSummary{
+						ID:   line.Project.Client.ID,
+						Name: line.Project.Client.Name,
+						Code: line.Project.Client.Code,
+					},
+				},
+				Currency:                line.Project.Currency,
+				ForecastAmountsResponse: mapForecastAmounts(&line.ForecastAmounts, past),
+			}
+		}
+		if week.Converted != nil {
+			converted := mapForecastAmounts(week.Converted, past)
+			weekResponse.Converted = &converted
+		}
+		response.Weeks[i] = weekResponse
+	}
+
+	if forecast.Converted != nil {
+		missingRates := forecast.Converted.MissingRates
+		if missingRates == nil {
+			missingRates = []string{}
+		}
+		response.Converted = &schemas.ConvertedForecastResponse{
+			Currency:     forecast.Converted.Currency,
+			Upcoming:     mapForecastAmounts(forecast.ConvertedUpcoming, false),
+			Past:         mapForecastAmounts(forecast.ConvertedPast, true),
+			MissingRates: missingRates,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func mapForecastTotals(totals []*services.ForecastTotal, past bool) []schemas.ForecastTotalResponse {
+	response := make([]schemas.ForecastTotalResponse, len(totals))
+	for i, total := range totals {
+		response[i] = schemas.ForecastTotalResponse{
+			Currency:                total.Currency,
+			ForecastAmountsResponse: mapForecastAmounts(&total.ForecastAmounts, past),
+		}
+	}
+	return response
+}
+
+func mapForecastAmounts(amounts *services.ForecastAmounts, past bool) schemas.ForecastAmountsResponse {
+	response := schemas.ForecastAmountsResponse{
+		AllocatedHours:  amounts.AllocatedHours,
+		ForecastAmount:  math.Round(amounts.ForecastAmount*100) / 100,
+		ActualHours:     amounts.ActualHours,
+		ActualAmount:    math.Round(amounts.ActualAmount*100) / 100,
+		ProjectedHours:  math.Round(amounts.ProjectedHours*100) / 100,
+		ProjectedAmount: math.Round(amounts.ProjectedAmount*100) / 100,
+	}
+	if past {
+		varianceHours := amounts.VarianceHours()
+		varianceAmount := math.Round(amounts.VarianceAmount()*100) / 100
+		response.VarianceHours = &varianceHours
+		response.VarianceAmount = &varianceAmount
+	}
+	return response
+}
+
+func (h *ReportHandler) mapProjectReportToResponse(report *services.ProjectReport) *schemas.ProjectReportResponse {
+	return &schemas.ProjectReportResponse{
+		Project: schemas.ProjectSummary{
+			ID:           report.Project.ID,
+			Name:         report.Project.Name,
+			Code:         report.Project.Code,
+			BillableRate: report.Project.BillableRate,
+			Currency:     report.Project.Currency,
+			Client: schemas.ClientSummary{
+				ID:   report.Project.Client.ID,
+				Name: report.Project.Client.Name,
+				Code: report.Project.Client.Code,
+			},
+		},
+		TotalHours:       report.TotalHours,
+		BillableHours:    report.BillableHours,
+		WrittenDownHours: report.WrittenDownHours,
+		BillableAmount:   report.BillableAmount,
+		Expenses:         h.mapExpenseTotalsToResponse(report.Expenses, report.Converted != nil),
+		Mileage:          schemas.TravelTotalResponse(report.Mileage),
+		PerDiem:          schemas.TravelTotalResponse(report.PerDiem),
+		Converted:        mapConvertedTotalsToResponse(report.Converted),
+	}
+}
+
+func (h *ReportHandler) mapExpenseTotalsToResponse(totals []*services.ExpenseTotal, converted bool) []schemas.ExpenseTotalResponse {
+	response := make([]schemas.ExpenseTotalResponse, len(totals))
+	for i, total := range totals {
+		byCategory := make(map[string]float64, len(total.ByCategory))
+		for category, amount := range total.ByCategory {
+			byCategory[string(category)] = amount
+		}
+		response[i] = schemas.ExpenseTotalResponse{
+			Currency:       total.Currency,
+			Amount:         total.Amount,
+			BillableAmount: total.BillableAmount,
+			ByCategory:     byCategory,
+		}
+		if converted {
+			response[i].ConvertedAmount = &total.ConvertedAmount
+			response[i].ConvertedBillableAmount = &total.ConvertedBillableAmount
+		}
+	}
+	return response
+}
+
+func mapConvertedTotalsToResponse(totals *services.ConvertedTotals) *schemas.ConvertedTotalsResponse {
+	if totals == nil {
+		return nil
+	}
+	missingRates := totals.MissingRates
+	if missingRates == nil {
+		missingRates = []string{}
+	}
+	return &schemas.ConvertedTotalsResponse{
+		Currency:              totals.Currency,
+		BillableAmount:        totals.BillableAmount,
+		ExpenseAmount:         totals.ExpenseAmount,
+		BillableExpenseAmount: totals.BillableExpenseAmount,
+		TravelAmount:          totals.TravelAmount,
+		BillableTravelAmount:  totals.BillableTravelAmount,
+		BillableTotal:         totals.BillableTotal(),
+		MissingRates:          missingRates,
+	}
+}
+
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/google/uuid"
+)
+
+func mapRevisionHistoryToResponse(entityType string, entityID uuid.UUID, revisions []*models.Revision) *schemas.RevisionHistoryResponse {
+	response := &schemas.RevisionHistoryResponse{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Revisions:  make([]schemas.RevisionResponse, len(revisions)),
+	}
+
+	for i, revision := range revisions {
+		response.Revisions[i] = mapRevisionToResponse(revision)
+	}
+
+	return response
+}
+
+func mapRevisionToResponse(revision *models.Revision) schemas.RevisionResponse {
+	var before, after map[string]interface{}
+	if len(revision.Before) > 0 {
+		json.Unmarshal(revision.Before, &before)
+	}
+	if len(revision.After) > 0 {
+		json.Unmarshal(revision.After, &after)
+	}
+
+	return schemas.RevisionResponse{
+		ID:            revision.ID,
+		Action:        string(revision.Action),
+		UserID:        revision.UserID,
+		Username:      revision.User.Username,
+		Before:        before,
+		After:         after,
+		ChangedFields: changedFields(before, after),
+		Override:      revision.Override,
+		Reason:        revision.Reason,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
+
+func changedFields(before, after map[string]interface{}) []string {
+	fields := []string{}
+	seen := make(map[string]bool)
+
+	for key, value := range after {
+		seen[key] = true
+		if previous, ok := before[key]; !ok || fmt.Sprint(previous) != fmt.Sprint(value) {
+			fields = append(fields, key)
+		}
+	}
+
+	for key := range before {
+		if !seen[key] {
+			fields = append(fields, key)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TaxRateHandler struct {
+	taxService *services.TaxService
+}
+
+func NewTaxRateHandler() *TaxRateHandler {
+	return &TaxRateHandler{
+		taxService: services.NewTaxService(),
+	}
+}
+
+func (h *TaxRateHandler) CreateRate(c *gin.Context) {
+	var req schemas.CreateTaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	effectiveFrom, _ := time.Parse("2006-01-02", req.EffectiveFrom)
+
+	rate, err := h.taxService.CreateRate(req.Country, req.Name, req.Rate, effectiveFrom)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create tax rate"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapTaxRateToResponse(rate))
+}
+
+func (h *TaxRateHandler) ListRates(c *gin.Context) {
+	var country *string
+	if value := c.Query("country"); value != "" {
+		country = &value
+	}
+
+	rates, err := h.taxService.ListRates(country)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tax rates"})
+		return
+	}
+
+	response := schemas.TaxRateListResponse{
+		Rates: make([]schemas.TaxRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = *mapTaxRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TaxRateHandler) DeleteRate(c *gin.Context) {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.taxService.DeleteRate(rateID); err != nil {
+		if err == services.ErrTaxRateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Tax rate not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete tax rate"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func mapTaxRateToResponse(rate *models.TaxRate) *schemas.TaxRateResponse {
+	return &schemas.TaxRateResponse{
+		ID:            rate.ID,
+		Country:       rate.Country,
+		Name:          rate.Name,
+		Rate:          rate.Rate,
+		EffectiveFrom: time.Time(rate.EffectiveFrom).Format("2006-01-02"),
+		CreatedAt:     rate.CreatedAt,
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"sort"
 	"strconv"
 	"time"
 
@@ -16,12 +17,16 @@ import (
 type TimeEntryHandler struct {
 	timeEntryService *services.TimeEntryService
 	projectService   *services.ProjectService
+	overtimeService  *services.OvertimeService
+	billingService   *services.BillingService
 }
 
 func NewTimeEntryHandler() *TimeEntryHandler {
 	return &TimeEntryHandler{
 		timeEntryService: services.NewTimeEntryService(),
 		projectService:   services.NewProjectService(),
+		overtimeService:  services.NewOvertimeService(),
+		billingService:   services.NewBillingService(),
 	}
 }
 
@@ -43,8 +48,13 @@ func (h *TimeEntryHandler) CreateTimeEntry(c *gin.Context) {
 
 	date, _ := time.Parse("2006-01-02", req.Date)
 
+	isBillable := true
+	if req.IsBillable != nil {
+		isBillable = *req.IsBillable
+	}
+
 	timeEntry, err := h.timeEntryService.CreateTimeEntry(
-		userID, req.ProjectID, date, req.Hours, req.Description, req.IsBillable,
+		userID, req.ProjectID, date, req.Hours, req.Description, isBillable,
 	)
 	if err != nil {
 		switch err {
@@ -232,6 +242,53 @@ func (h *TimeEntryHandler) GetWeekEntries(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (h *TimeEntryHandler) GetMonthEntries(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	month, ok := parseOptionalMonth(c)
+	if !ok {
+		return
+	}
+
+	period, err := h.timeEntryService.ResolveMonth(userID, month)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch month entries"})
+		return
+	}
+
+	entries, dailyTotals, weeklyTotals, err := h.timeEntryService.GetMonthEntries(userID, period)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch month entries"})
+		return
+	}
+
+	response := schemas.MonthEntriesResponse{
+		Month:        period.StartDate.Format("2006-01"),
+		StartDate:    period.StartDate.Format("2006-01-02"),
+		EndDate:      period.EndDate.Format("2006-01-02"),
+		TimeZone:     period.TimeZone,
+		TimeEntries:  make([]schemas.TimeEntryResponse, len(entries)),
+		DailyTotals:  dailyTotals,
+		WeeklyTotals: weeklyTotals,
+	}
+
+	for i, entry := range entries {
+		response.TimeEntries[i] = *h.mapTimeEntryToResponse(entry)
+		response.MonthTotal += entry.Hours
+		if entry.IsBillable {
+			response.BillableHours += entry.Hours
+		} else {
+			response.NonBillableHours += entry.Hours
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 func (h *TimeEntryHandler) GetProjectWeekComparison(c *gin.Context) {
 	userID, err := middleware.GetUserID(c)
 	if err != nil {
@@ -306,9 +363,22 @@ func (h *TimeEntryHandler) GetWeekSummary(c *gin.Context) {
 		return
 	}
 
+	overtime, billing, err := h.timeEntryService.GetWeekOvertime(userID, week)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get week summary"})
+		return
+	}
+
+	projectOvertime := overtime.ByProject()
+	billableAmounts := make(map[uuid.UUID]float64)
+	for _, projectBilling := range billing {
+		billableAmounts[projectBilling.Project.ID] = projectBilling.TotalAmount()
+	}
+
 	response := schemas.WeekSummaryResponse{
 		WeekStarting: week.Format("2006-01-02"),
 		Projects:     []schemas.ProjectWeekSummary{},
+		Overtime:     mapOvertimeSplitToResponse(overtime.Totals),
 	}
 
 	for projectID, hours := range summary {
@@ -319,6 +389,97 @@ func (h *TimeEntryHandler) GetWeekSummary(c *gin.Context) {
 			AllocatedHours: hours["allocated"],
 			ActualHours:    hours["actual"],
 			Variance:       hours["actual"] - hours["allocated"],
+			BillableAmount: billableAmounts[projectID],
+		}
+
+		if split, exists := projectOvertime[projectID]; exists {
+			projectSummary.Overtime = mapOvertimeSplitToResponse(*split)
+		}
+
+		if project != nil {
+			projectSummary.Project = &schemas.ProjectSummary{
+				ID:           project.ID,
+				Name:         project.Name,
+				Code:         project.Code,
+				BillableRate: project.BillableRate,
+				Currency:     project.Currency,
+				Client: schemas.ClientSummary{
+					ID:   project.Client.ID,
+					Name: project.Client.Name,
+					Code: project.Client.Code,
+				},
+			}
+		}
+
+		response.Projects = append(response.Projects, projectSummary)
+		response.TotalAllocated += hours["allocated"]
+		response.TotalActual += hours["actual"]
+		response.TotalBillableAmount += billableAmounts[projectID]
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TimeEntryHandler) GetMonthSummary(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	month, ok := parseOptionalMonth(c)
+	if !ok {
+		return
+	}
+
+	period, err := h.timeEntryService.ResolveMonth(userID, month)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get month summary"})
+		return
+	}
+
+	summary, err := h.timeEntryService.GetMonthSummary(userID, period)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get month summary"})
+		return
+	}
+
+	overtime, billing, err := h.timeEntryService.GetMonthOvertime(userID, period)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get month summary"})
+		return
+	}
+
+	projectOvertime := overtime.ByProject()
+	billableAmounts := make(map[uuid.UUID]float64)
+	for _, projectBilling := range billing {
+		billableAmounts[projectBilling.Project.ID] = projectBilling.TotalAmount()
+	}
+
+	response := schemas.MonthSummaryResponse{
+		Month:     period.StartDate.Format("2006-01"),
+		StartDate: period.StartDate.Format("2006-01-02"),
+		EndDate:   period.EndDate.Format("2006-01-02"),
+		TimeZone:  period.TimeZone,
+		Projects:  []schemas.ProjectMonthSummary{},
+		Overtime:  mapOvertimeSplitToResponse(overtime.Totals),
+	}
+
+	for projectID, hours := range summary {
+		project, _ := h.projectService.GetProjectByID(userID, projectID)
+
+		projectSummary := schemas.ProjectMonthSummary{
+			ProjectID:        projectID,
+			AllocatedHours:   hours["allocated"],
+			ActualHours:      hours["actual"],
+			Variance:         hours["actual"] - hours["allocated"],
+			BillableHours:    hours["billable"],
+			NonBillableHours: hours["non_billable"],
+			BillableAmount:   billableAmounts[projectID],
+		}
+
+		if split, exists := projectOvertime[projectID]; exists {
+			projectSummary.Overtime = mapOvertimeSplitToResponse(*split)
 		}
 
 		if project != nil {
@@ -339,6 +500,112 @@ func (h *TimeEntryHandler) GetWeekSummary(c *gin.Context) {
 		response.Projects = append(response.Projects, projectSummary)
 		response.TotalAllocated += hours["allocated"]
 		response.TotalActual += hours["actual"]
+		response.TotalBillable += hours["billable"]
+		response.TotalNonBillable += hours["non_billable"]
+		response.TotalBillableAmount += billableAmounts[projectID]
+	}
+
+	sort.Slice(response.Projects, func(i, j int) bool {
+		if response.Projects[i].Project == nil || response.Projects[j].Project == nil {
+			return response.Projects[i].Project != nil
+		}
+		return response.Projects[i].Project.Name < response.Projects[j].Project.Name
+	})
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TimeEntryHandler) GetOvertimeReport(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	overtime, err := h.overtimeService.CalculateOvertime(userID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to calculate overtime"})
+		return
+	}
+
+	response := schemas.OvertimeReportResponse{
+		StartDate:  startDate.Format("2006-01-02"),
+		EndDate:    endDate.Format("2006-01-02"),
+		Rule:       *mapOvertimeRuleToResponse(&overtime.Rule),
+		TotalHours: overtime.Totals.TotalHours(),
+		Totals:     mapOvertimeSplitToResponse(overtime.Totals),
+		Entries:    make([]schemas.TimeEntryOvertimeResponse, len(overtime.Entries)),
+	}
+
+	for i, entry := range overtime.Entries {
+		response.Entries[i] = schemas.TimeEntryOvertimeResponse{
+			TimeEntryID: entry.TimeEntry.ID,
+			ProjectID:   entry.TimeEntry.ProjectID,
+			Date:        time.Time(entry.TimeEntry.Date).Format("2006-01-02"),
+			Hours:       entry.TimeEntry.Hours,
+			Split:       mapOvertimeSplitToResponse(entry.OvertimeSplit),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TimeEntryHandler) GetBillingSummary(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	startDate, endDate, ok := parseDateRange(c)
+	if !ok {
+		return
+	}
+
+	billing, err := h.billingService.CalculateBilling(userID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to calculate billing"})
+		return
+	}
+
+	response := schemas.BillingSummaryResponse{
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		Projects:  make([]schemas.ProjectBillingResponse, len(billing)),
+	}
+
+	for i, projectBilling := range billing {
+		project := projectBilling.Project
+		response.Projects[i] = schemas.ProjectBillingResponse{
+			ProjectID: project.ID,
+			Project: &schemas.ProjectSummary{
+				ID:           project.ID,
+				Name:         project.Name,
+				Code:         project.Code,
+				BillableRate: project.BillableRate,
+				Currency:     project.Currency,
+				Client: schemas.ClientSummary{
+					ID:   project.Client.ID,
+					Name: project.Client.Name,
+					Code: project.Client.Code,
+				},
+			},
+			BillableHours: projectBilling.Split.TotalHours(),
+			Split:         mapOvertimeSplitToResponse(projectBilling.Split),
+			BaseAmount:    projectBilling.BaseAmount,
+			PremiumAmount: projectBilling.PremiumAmount,
+			TotalAmount:   projectBilling.TotalAmount(),
+		}
+
+		response.TotalBillableHours += projectBilling.Split.TotalHours()
+		response.TotalBaseAmount += projectBilling.BaseAmount
+		response.TotalPremiumAmount += projectBilling.PremiumAmount
+		response.TotalAmount += projectBilling.TotalAmount()
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -366,13 +633,15 @@ func (h *TimeEntryHandler) UpdateTimeEntry(c *gin.Context) {
 		return
 	}
 
-	timeEntry, err := h.timeEntryService.UpdateTimeEntry(userID, timeEntryID, req.Hours, req.Description, req.IsBillable)
+	override, err := parseLockOverride(c)
 	if err != nil {
-		if err == services.ErrTimeEntryNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update time entry"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid override flag"})
+		return
+	}
+
+	timeEntry, err := h.timeEntryService.UpdateTimeEntry(userID, timeEntryID, req.Hours, req.Description, req.IsBillable, override)
+	if err != nil {
+		handleTimeEntryLockError(c, err, "Failed to update time entry")
 		return
 	}
 
@@ -392,28 +661,189 @@ func (h *TimeEntryHandler) DeleteTimeEntry(c *gin.Context) {
 		return
 	}
 
-	if err := h.timeEntryService.DeleteTimeEntry(userID, timeEntryID); err != nil {
+	override, err := parseLockOverride(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid override flag"})
+		return
+	}
+
+	if err := h.timeEntryService.DeleteTimeEntry(userID, timeEntryID, override); err != nil {
+		handleTimeEntryLockError(c, err, "Failed to delete time entry")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *TimeEntryHandler) GetTimeEntryHistory(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	revisions, err := h.timeEntryService.GetTimeEntryHistory(userID, timeEntryID)
+	if err != nil {
 		if err == services.ErrTimeEntryNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete time entry"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch time entry history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, mapRevisionHistoryToResponse(models.RevisionEntityTimeEntry, timeEntryID, revisions))
+}
+
+func (h *TimeEntryHandler) ListDeletedTimeEntries(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	timeEntries, total, err := h.timeEntryService.ListDeletedTimeEntries(userID, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch deleted time entries"})
+		return
+	}
+
+	response := schemas.TimeEntryListResponse{
+		TimeEntries: make([]schemas.TimeEntryResponse, len(timeEntries)),
+		Total:       total,
+		Offset:      offset,
+		Limit:       limit,
+	}
+
+	for i, timeEntry := range timeEntries {
+		response.TimeEntries[i] = *h.mapTimeEntryToResponse(timeEntry)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TimeEntryHandler) RestoreTimeEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	timeEntry, err := h.timeEntryService.RestoreTimeEntry(userID, timeEntryID)
+	if err != nil {
+		switch err {
+		case services.ErrTimeEntryNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found in trash"})
+		case services.ErrTimeEntryExists, services.ErrProjectDeleted:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore time entry"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapTimeEntryToResponse(timeEntry))
+}
+
+func (h *TimeEntryHandler) PurgeTimeEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	if err := h.timeEntryService.PurgeTimeEntry(userID, timeEntryID); err != nil {
+		switch err {
+		case services.ErrTimeEntryNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found in trash"})
+		case services.ErrTimeEntryLocked:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to purge time entry"})
+		}
 		return
 	}
 
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func parseLockOverride(c *gin.Context) (*services.LockOverride, error) {
+	value := c.Query("override")
+	if value == "" {
+		return nil, nil
+	}
+
+	override, err := strconv.ParseBool(value)
+	if err != nil || !override {
+		return nil, err
+	}
+
+	return &services.LockOverride{Reason: c.Query("reason")}, nil
+}
+
+func handleTimeEntryLockError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrTimeEntryNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
+	case services.ErrTimeEntryLocked:
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   err.Error(),
+			"details": "Void the invoice or ask an administrator to override the lock",
+		})
+	case services.ErrOverrideReasonRequired, services.ErrWriteDownExceedsHours:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrOverrideNotPermitted:
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
 func (h *TimeEntryHandler) mapTimeEntryToResponse(entry *models.TimeEntry) *schemas.TimeEntryResponse {
 	response := &schemas.TimeEntryResponse{
-		ID:          entry.ID,
-		ProjectID:   entry.ProjectID,
-		Date:        time.Time(entry.Date).Format("2006-01-02"),
-		Hours:       entry.Hours,
-		Description: entry.Description,
-		IsBillable:  entry.IsBillable,
-		CreatedAt:   entry.CreatedAt,
-		UpdatedAt:   entry.UpdatedAt,
+		ID:               entry.ID,
+		ProjectID:        entry.ProjectID,
+		Date:             time.Time(entry.Date).Format("2006-01-02"),
+		Hours:            entry.Hours,
+		Description:      entry.Description,
+		IsBillable:       entry.IsBillable,
+		WrittenDownHours: entry.WrittenDownHours,
+		BillableHours:    entry.BillableHours(),
+		CreditedHours:    entry.CreditedHours,
+		InvoiceID:        entry.InvoiceID,
+		IsLocked:         entry.IsLocked(),
+		CreatedAt:        entry.CreatedAt,
+		UpdatedAt:        entry.UpdatedAt,
+	}
+
+	if entry.DeletedAt.Valid {
+		deletedAt := entry.DeletedAt.Time
+		response.DeletedAt = &deletedAt
 	}
 
 	if entry.Project.ID != uuid.Nil {
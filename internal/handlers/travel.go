@@ -0,0 +1,463 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelHandler struct {
+	travelService *services.TravelService
+}
+
+func NewTravelHandler() *TravelHandler {
+	return &TravelHandler{
+		travelService: services.NewTravelService(),
+	}
+}
+
+func (h *TravelHandler) CreateMileageEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreateMileageEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+
+	isBillable := true
+	if req.IsBillable != nil {
+		isBillable = *req.IsBillable
+	}
+
+	entry, err := h.travelService.CreateMileageEntry(
+		userID, req.ProjectID, date, req.FromLocation, req.ToLocation, req.Distance,
+		models.VehicleType(req.VehicleType), req.Description, isBillable,
+	)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to create mileage entry")
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapMileageEntryToResponse(entry))
+}
+
+func (h *TravelHandler) GetMileageEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mileage entry ID"})
+		return
+	}
+
+	entry, err := h.travelService.GetMileageEntry(userID, entryID)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to fetch mileage entry")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapMileageEntryToResponse(entry))
+}
+
+func (h *TravelHandler) ListMileageEntries(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	entries, total, err := h.travelService.ListMileageEntries(userID, h.parseTravelFilter(c), offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch mileage entries"})
+		return
+	}
+
+	response := schemas.MileageEntryListResponse{
+		MileageEntries: make([]schemas.MileageEntryResponse, len(entries)),
+		Total:          total,
+		Offset:         offset,
+		Limit:          limit,
+	}
+
+	for i, entry := range entries {
+		response.MileageEntries[i] = *h.mapMileageEntryToResponse(entry)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TravelHandler) UpdateMileageEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mileage entry ID"})
+		return
+	}
+
+	var req schemas.UpdateMileageEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Date != nil {
+		date, _ := time.Parse("2006-01-02", *req.Date)
+		updates["date"] = date
+	}
+	if req.FromLocation != nil {
+		updates["from_location"] = *req.FromLocation
+	}
+	if req.ToLocation != nil {
+		updates["to_location"] = *req.ToLocation
+	}
+	if req.Distance != nil {
+		updates["distance"] = *req.Distance
+	}
+	if req.VehicleType != nil {
+		updates["vehicle_type"] = *req.VehicleType
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.IsBillable != nil {
+		updates["is_billable"] = *req.IsBillable
+	}
+
+	entry, err := h.travelService.UpdateMileageEntry(userID, entryID, updates)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to update mileage entry")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapMileageEntryToResponse(entry))
+}
+
+func (h *TravelHandler) DeleteMileageEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	entryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mileage entry ID"})
+		return
+	}
+
+	if err := h.travelService.DeleteMileageEntry(userID, entryID); err != nil {
+		h.handleTravelError(c, err, "Failed to delete mileage entry")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *TravelHandler) CreatePerDiemClaim(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	var req schemas.CreatePerDiemClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", req.Date)
+
+	isBillable := true
+	if req.IsBillable != nil {
+		isBillable = *req.IsBillable
+	}
+
+	claim, err := h.travelService.CreatePerDiemClaim(userID, req.ProjectID, date, req.Days, req.Country, req.Description, isBillable)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to create per-diem claim")
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapPerDiemClaimToResponse(claim))
+}
+
+func (h *TravelHandler) GetPerDiemClaim(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	claimID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid per-diem claim ID"})
+		return
+	}
+
+	claim, err := h.travelService.GetPerDiemClaim(userID, claimID)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to fetch per-diem claim")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapPerDiemClaimToResponse(claim))
+}
+
+func (h *TravelHandler) ListPerDiemClaims(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit > 100 {
+		limit = 100
+	}
+
+	claims, total, err := h.travelService.ListPerDiemClaims(userID, h.parseTravelFilter(c), offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch per-diem claims"})
+		return
+	}
+
+	response := schemas.PerDiemClaimListResponse{
+		PerDiemClaims: make([]schemas.PerDiemClaimResponse, len(claims)),
+		Total:         total,
+		Offset:        offset,
+		Limit:         limit,
+	}
+
+	for i, claim := range claims {
+		response.PerDiemClaims[i] = *h.mapPerDiemClaimToResponse(claim)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TravelHandler) UpdatePerDiemClaim(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	claimID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid per-diem claim ID"})
+		return
+	}
+
+	var req schemas.UpdatePerDiemClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updates := make(map[string]interface{})
+	if req.Date != nil {
+		date, _ := time.Parse("2006-01-02", *req.Date)
+		updates["date"] = date
+	}
+	if req.Days != nil {
+		updates["days"] = *req.Days
+	}
+	if req.Country != nil {
+		updates["country"] = *req.Country
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.IsBillable != nil {
+		updates["is_billable"] = *req.IsBillable
+	}
+
+	claim, err := h.travelService.UpdatePerDiemClaim(userID, claimID, updates)
+	if err != nil {
+		h.handleTravelError(c, err, "Failed to update per-diem claim")
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapPerDiemClaimToResponse(claim))
+}
+
+func (h *TravelHandler) DeletePerDiemClaim(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	claimID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid per-diem claim ID"})
+		return
+	}
+
+	if err := h.travelService.DeletePerDiemClaim(userID, claimID); err != nil {
+		h.handleTravelError(c, err, "Failed to delete per-diem claim")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *TravelHandler) handleTravelError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrMileageEntryNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Mileage entry not found"})
+	case services.ErrPerDiemClaimNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Per-diem claim not found"})
+	case services.ErrDateInFuture:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot claim travel for future dates"})
+	case services.ErrInvalidVehicleType:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrTravelClaimLocked:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case services.ErrMileageRateNotFound, services.ErrPerDiemRateNotFound:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		if err.Error() == "project not found or access denied" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+		}
+	}
+}
+
+func (h *TravelHandler) parseTravelFilter(c *gin.Context) services.TravelFilter {
+	var filter services.TravelFilter
+	if projectIDStr := c.Query("project_id"); projectIDStr != "" {
+		if id, err := uuid.Parse(projectIDStr); err == nil {
+			filter.ProjectID = &id
+		}
+	}
+
+	if startStr := c.Query("start_date"); startStr != "" {
+		if date, err := time.Parse("2006-01-02", startStr); err == nil {
+			filter.StartDate = &date
+		}
+	}
+	if endStr := c.Query("end_date"); endStr != "" {
+		if date, err := time.Parse("2006-01-02", endStr); err == nil {
+			filter.EndDate = &date
+		}
+	}
+
+	if billableStr := c.Query("is_billable"); billableStr != "" {
+		billable := billableStr == "true"
+		filter.IsBillable = &billable
+	}
+
+	return filter
+}
+
+func (h *TravelHandler) mapProjectSummary(project models.Project) *schemas.ProjectSummary {
+	if project.ID == uuid.Nil {
+		return nil
+	}
+
+	return &schemas.ProjectSummary{
+		ID:           project.ID,
+		Name:         project.Name,
+		Code:         project.Code,
+		BillableRate: project.BillableRate,
+		Currency:     project.Currency,
+		Client: schemas.ClientSummary{
+			ID:   project.Client.ID,
+			Name: project.Client.Name,
+			Code: project.Client.Code,
+		},
+	}
+}
+
+func (h *TravelHandler) mapMileageEntryToResponse(entry *models.MileageEntry) *schemas.MileageEntryResponse {
+	return &schemas.MileageEntryResponse{
+		ID:           entry.ID,
+		ProjectID:    entry.ProjectID,
+		Project:      h.mapProjectSummary(entry.Project),
+		Date:         time.Time(entry.Date).Format("2006-01-02"),
+		FromLocation: entry.FromLocation,
+		ToLocation:   entry.ToLocation,
+		Distance:     entry.Distance,
+		DistanceUnit: entry.Rate.DistanceUnit,
+		VehicleType:  string(entry.VehicleType),
+		Description:  entry.Description,
+		IsBillable:   entry.IsBillable,
+		RateID:       entry.RateID,
+		RateVersion:  entry.Rate.Version,
+		RatePerUnit:  entry.RatePerUnit,
+		Currency:     entry.Currency,
+		Amount:       entry.Amount,
+		InvoiceID:    entry.InvoiceID,
+		IsLocked:     entry.IsLocked(),
+		CreatedAt:    entry.CreatedAt,
+		UpdatedAt:    entry.UpdatedAt,
+	}
+}
+
+func (h *TravelHandler) mapPerDiemClaimToResponse(claim *models.PerDiemClaim) *schemas.PerDiemClaimResponse {
+	return &schemas.PerDiemClaimResponse{
+		ID:          claim.ID,
+		ProjectID:   claim.ProjectID,
+		Project:     h.mapProjectSummary(claim.Project),
+		Date:        time.Time(claim.Date).Format("2006-01-02"),
+		Days:        claim.Days,
+		Country:     claim.Country,
+		Description: claim.Description,
+		IsBillable:  claim.IsBillable,
+		RateID:      claim.RateID,
+		RateVersion: claim.Rate.Version,
+		DailyRate:   claim.DailyRate,
+		Currency:    claim.Currency,
+		Amount:      claim.Amount,
+		InvoiceID:   claim.InvoiceID,
+		IsLocked:    claim.IsLocked(),
+		CreatedAt:   claim.CreatedAt,
+		UpdatedAt:   claim.UpdatedAt,
+	}
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelRateHandler struct {
+	rateService *services.TravelRateService
+}
+
+func NewTravelRateHandler() *TravelRateHandler {
+	return &TravelRateHandler{
+		rateService: services.NewTravelRateService(),
+	}
+}
+
+func (h *TravelRateHandler) CreateMileageRate(c *gin.Context) {
+	var req schemas.CreateMileageRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	effectiveFrom, _ := time.Parse("2006-01-02", req.EffectiveFrom)
+
+	distanceUnit := req.DistanceUnit
+	if distanceUnit == "" {
+		distanceUnit = "km"
+	}
+
+	rate, err := h.rateService.CreateMileageRate(models.VehicleType(req.VehicleType), req.Currency, req.RatePerUnit, distanceUnit, effectiveFrom)
+	if err != nil {
+		if err == services.ErrInvalidVehicleType {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create mileage rate"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapMileageRateToResponse(rate))
+}
+
+func (h *TravelRateHandler) ListMileageRates(c *gin.Context) {
+	var vehicleType *models.VehicleType
+	if vehicleTypeStr := c.Query("vehicle_type"); vehicleTypeStr != "" {
+		value := models.VehicleType(vehicleTypeStr)
+		vehicleType = &value
+	}
+
+	var currency *string
+	if currencyStr := c.Query("currency"); currencyStr != "" {
+		currency = &currencyStr
+	}
+
+	rates, err := h.rateService.ListMileageRates(vehicleType, currency)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch mileage rates"})
+		return
+	}
+
+	response := schemas.MileageRateListResponse{
+		Rates: make([]schemas.MileageRateResponse, len(rates)),
+	}
+
+	for i, rate := range rates {
+		response.Rates[i] = *h.mapMileageRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TravelRateHandler) DeleteMileageRate(c *gin.Context) {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.rateService.DeleteMileageRate(rateID); err != nil {
+		switch err {
+		case services.ErrMileageRateNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Mileage rate not found"})
+		case services.ErrTravelRateInUse:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete mileage rate"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *TravelRateHandler) CreatePerDiemRate(c *gin.Context) {
+	var req schemas.CreatePerDiemRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	effectiveFrom, _ := time.Parse("2006-01-02", req.EffectiveFrom)
+
+	rate, err := h.rateService.CreatePerDiemRate(req.Country, req.Currency, req.DailyRate, effectiveFrom)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create per-diem rate"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.mapPerDiemRateToResponse(rate))
+}
+
+func (h *TravelRateHandler) ListPerDiemRates(c *gin.Context) {
+	var country, currency *string
+	if countryStr := c.Query("country"); countryStr != "" {
+		country = &countryStr
+	}
+	if currencyStr := c.Query("currency"); currencyStr != "" {
+		currency = &currencyStr
+	}
+
+	rates, err := h.rateService.ListPerDiemRates(country, currency)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch per-diem rates"})
+		return
+	}
+
+	response := schemas.PerDiemRateListResponse{
+		Rates: make([]schemas.PerDiemRateResponse, len(rates)),
+	}
+
+	for i, rate := range rates {
+		response.Rates[i] = *h.mapPerDiemRateToResponse(rate)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TravelRateHandler) DeletePerDiemRate(c *gin.Context) {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rate ID"})
+		return
+	}
+
+	if err := h.rateService.DeletePerDiemRate(rateID); err != nil {
+		switch err {
+		case services.ErrPerDiemRateNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Per-diem rate not found"})
+		case services.ErrTravelRateInUse:
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete per-diem rate"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *TravelRateHandler) mapMileageRateToResponse(rate *models.MileageRate) *schemas.MileageRateResponse {
+	return &schemas.MileageRateResponse{
+		ID:            rate.ID,
+		VehicleType:   string(rate.VehicleType),
+		Currency:      rate.Currency,
+		RatePerUnit:   rate.RatePerUnit,
+		DistanceUnit:  rate.DistanceUnit,
+		EffectiveFrom: time.Time(rate.EffectiveFrom).Format("2006-01-02"),
+		Version:       rate.Version,
+		CreatedAt:     rate.CreatedAt,
+	}
+}
+
+func (h *TravelRateHandler) mapPerDiemRateToResponse(rate *models.PerDiemRate) *schemas.PerDiemRateResponse {
+	return &schemas.PerDiemRateResponse{
+		ID:            rate.ID,
+		Country:       rate.Country,
+		Currency:      rate.Currency,
+		DailyRate:     rate.DailyRate,
+		EffectiveFrom: time.Time(rate.EffectiveFrom).Format("2006-01-02"),
+		Version:       rate.Version,
+		CreatedAt:     rate.CreatedAt,
+	}
+}
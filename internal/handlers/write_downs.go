@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/middleware"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/schemas"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WriteDownHandler struct {
+	writeDownService *services.WriteDownService
+}
+
+func NewWriteDownHandler() *WriteDownHandler {
+	return &WriteDownHandler{
+		writeDownService: services.NewWriteDownService(),
+	}
+}
+
+func (h *WriteDownHandler) WriteDownTimeEntry(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	var req schemas.CreateWriteDownRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	writeDown, err := h.writeDownService.WriteDownTimeEntry(userID, timeEntryID, req.Hours, models.WriteDownReason(req.Reason), req.Notes)
+	if err != nil {
+		h.handleWriteDownError(c, err, "Failed to write down time entry")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapWriteDownToResponse(writeDown))
+}
+
+func (h *WriteDownHandler) WriteDownInvoiceLine(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	lineID, err := uuid.Parse(c.Param("lineId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice line ID"})
+		return
+	}
+
+	var req schemas.CreateWriteDownRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	writeDown, err := h.writeDownService.WriteDownInvoiceLine(userID, invoiceID, lineID, req.Hours, models.WriteDownReason(req.Reason), req.Notes)
+	if err != nil {
+		h.handleWriteDownError(c, err, "Failed to write down invoice line")
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapWriteDownToResponse(writeDown))
+}
+
+func (h *WriteDownHandler) ListTimeEntryWriteDowns(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	timeEntryID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time entry ID"})
+		return
+	}
+
+	writeDowns, err := h.writeDownService.ListTimeEntryWriteDowns(userID, timeEntryID)
+	if err != nil {
+		h.handleWriteDownError(c, err, "Failed to fetch write-downs")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapWriteDownsToResponse(writeDowns))
+}
+
+func (h *WriteDownHandler) ListInvoiceWriteDowns(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	writeDowns, err := h.writeDownService.ListInvoiceWriteDowns(userID, invoiceID)
+	if err != nil {
+		h.handleWriteDownError(c, err, "Failed to fetch write-downs")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapWriteDownsToResponse(writeDowns))
+}
+
+func (h *WriteDownHandler) DeleteWriteDown(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user context"})
+		return
+	}
+
+	writeDownID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid write-down ID"})
+		return
+	}
+
+	if err := h.writeDownService.DeleteWriteDown(userID, writeDownID); err != nil {
+		h.handleWriteDownError(c, err, "Failed to delete write-down")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *WriteDownHandler) handleWriteDownError(c *gin.Context, err error, message string) {
+	switch err {
+	case services.ErrTimeEntryNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Time entry not found"})
+	case services.ErrInvoiceNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+	case services.ErrInvoiceLineNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case services.ErrWriteDownNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Write-down not found"})
+	case services.ErrInvalidWriteDownReason, services.ErrWriteDownExceedsHours, services.ErrTimeEntryNotBillable, services.ErrWriteDownNotTimeLine:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case services.ErrTimeEntryLocked, services.ErrInvalidInvoiceTransition:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case services.ErrTaxRateNotFound:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+func mapWriteDownsToResponse(writeDowns []*models.WriteDown) schemas.WriteDownListResponse {
+	response := schemas.WriteDownListResponse{
+		WriteDowns: make([]schemas.WriteDownResponse, len(writeDowns)),
+	}
+	for i, writeDown := range writeDowns {
+		response.WriteDowns[i] = *mapWriteDownToResponse(writeDown)
+		response.TotalHours += writeDown.Hours
+	}
+	return response
+}
+
+func mapWriteDownToResponse(writeDown *models.WriteDown) *schemas.WriteDownResponse {
+	return &schemas.WriteDownResponse{
+		ID:            writeDown.ID,
+		TimeEntryID:   writeDown.TimeEntryID,
+		InvoiceID:     writeDown.InvoiceID,
+		InvoiceLineID: writeDown.InvoiceLineID,
+		Hours:         writeDown.Hours,
+		Reason:        string(writeDown.Reason),
+		Notes:         writeDown.Notes,
+		CreatedAt:     writeDown.CreatedAt,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -63,6 +64,19 @@ func BasicAuth() gin.HandlerFunc {
 	}
 }
 
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, exists := c.Get("user")
+		if !exists || !user.(*models.User).IsAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "Administrator access required",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
 func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	userIDStr, exists := c.Get("user_id")
 	if !exists {
@@ -0,0 +1,30 @@
+package models
+
+import "github.com/google/uuid"
+
+type AccountMapping struct {
+	BaseModel
+	UserID            uuid.UUID  `gorm:"not null;index" json:"user_id"`
+	ClientID          *uuid.UUID `gorm:"index" json:"client_id"`
+	ProjectID         *uuid.UUID `gorm:"index" json:"project_id"`
+	ReceivableAccount string     `json:"receivable_account"`
+	RevenueAccount    string     `json:"revenue_account"`
+	TaxAccount        string     `json:"tax_account"`
+	BankAccount       string     `json:"bank_account"`
+	Client            *Client    `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	Project           *Project   `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User              User       `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (AccountMapping) TableName() string {
+	return "account_mappings"
+}
+
+func DefaultAccountMapping() AccountMapping {
+	return AccountMapping{
+		ReceivableAccount: "610",
+		RevenueAccount:    "200",
+		TaxAccount:        "820",
+		BankAccount:       "090",
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import "github.com/google/uuid"
+
+type Attachment struct {
+	BaseModel
+	TimeEntryID uuid.UUID `gorm:"not null;index" json:"time_entry_id"`
+	UserID      uuid.UUID `gorm:"not null" json:"user_id"`
+	FileName    string    `gorm:"not null" json:"file_name"`
+	ContentType string    `gorm:"not null" json:"content_type"`
+	Size        int64     `gorm:"not null" json:"size"`
+	Checksum    string    `gorm:"not null" json:"checksum"`
+	StorageKey  string    `gorm:"not null" json:"-"`
+	TimeEntry   TimeEntry `gorm:"foreignKey:TimeEntryID" json:"-"`
+	User        User      `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (Attachment) TableName() string {
+	return "attachments"
+}
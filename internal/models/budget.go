@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type BudgetMetric string
+
+const (
+	BudgetMetricHours  BudgetMetric = "hours"
+	BudgetMetricAmount BudgetMetric = "amount"
+)
+
+var DefaultBudgetThresholds = []float64{75, 90, 100}
+
+type ProjectBudget struct {
+	BaseModel
+	ProjectID  uuid.UUID                    `gorm:"not null;index" json:"project_id"`
+	Phase      string                       `json:"phase"`
+	StartDate  *datatypes.Date              `json:"start_date"`
+	EndDate    *datatypes.Date              `json:"end_date"`
+	Hours      float64                      `gorm:"not null" json:"hours"`
+	Amount     float64                      `gorm:"not null" json:"amount"`
+	Thresholds datatypes.JSONSlice[float64] `json:"thresholds"`
+	Project    Project                      `gorm:"foreignKey:ProjectID" json:"-"`
+}
+
+func (ProjectBudget) TableName() string {
+	return "project_budgets"
+}
+
+func (b *ProjectBudget) Label() string {
+	if b.Phase == "" {
+		return "Project budget"
+	}
+	return b.Phase
+}
+
+type BudgetAlert struct {
+	BaseModel
+	BudgetID  uuid.UUID    `gorm:"not null;uniqueIndex:idx_budget_alerts_threshold" json:"budget_id"`
+	Metric    BudgetMetric `gorm:"not null;uniqueIndex:idx_budget_alerts_threshold" json:"metric"`
+	Threshold float64      `gorm:"not null;uniqueIndex:idx_budget_alerts_threshold" json:"threshold"`
+}
+
+func (BudgetAlert) TableName() string {
+	return "budget_alerts"
+}
@@ -0,0 +1,30 @@
+package models
+
+import "github.com/google/uuid"
+
+type CompanyProfile struct {
+	BaseModel
+	UserID            uuid.UUID `gorm:"not null;uniqueIndex" json:"user_id"`
+	Name              string    `json:"name"`
+	Address           string    `json:"address"`
+	Email             string    `json:"email"`
+	Phone             string    `json:"phone"`
+	Website           string    `json:"website"`
+	TaxID             string    `json:"tax_id"`
+	Country           string    `json:"country"`
+	PeppolID          string    `json:"peppol_id"`
+	BankDetails       string    `json:"bank_details"`
+	ReportingCurrency string    `json:"reporting_currency"`
+	LogoFileName      string    `json:"logo_file_name"`
+	LogoContentType   string    `json:"logo_content_type"`
+	LogoStorageKey    string    `json:"-"`
+	User              User      `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (CompanyProfile) TableName() string {
+	return "company_profiles"
+}
+
+func (p *CompanyProfile) HasLogo() bool {
+	return p.LogoStorageKey != ""
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type CreditNote struct {
+	BaseModel
+	Number        string           `gorm:"not null;index" json:"number"`
+	UserID        uuid.UUID        `gorm:"not null;index" json:"user_id"`
+	ClientID      uuid.UUID        `gorm:"not null;index" json:"client_id"`
+	InvoiceID     uuid.UUID        `gorm:"not null;index" json:"invoice_id"`
+	IssueDate     datatypes.Date   `gorm:"not null" json:"issue_date"`
+	Currency      string           `gorm:"not null" json:"currency"`
+	Reason        string           `gorm:"not null" json:"reason"`
+	TotalHours    float64          `gorm:"not null" json:"total_hours"`
+	Subtotal      float64          `gorm:"not null" json:"subtotal"`
+	TaxTotal      float64          `gorm:"not null" json:"tax_total"`
+	Total         float64          `gorm:"not null" json:"total"`
+	AppliedAmount float64          `gorm:"not null" json:"applied_amount"`
+	Invoice       Invoice          `gorm:"foreignKey:InvoiceID" json:"-"`
+	Client        Client           `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	Lines         []CreditNoteLine `gorm:"foreignKey:CreditNoteID" json:"lines,omitempty"`
+}
+
+func (CreditNote) TableName() string {
+	return "credit_notes"
+}
+
+func (n *CreditNote) CreditAmount() float64 {
+	return n.Total - n.AppliedAmount
+}
+
+type CreditNoteLine struct {
+	BaseModel
+	CreditNoteID    uuid.UUID       `gorm:"not null;index" json:"credit_note_id"`
+	InvoiceLineID   uuid.UUID       `gorm:"not null;index" json:"invoice_line_id"`
+	ProjectID       uuid.UUID       `gorm:"not null" json:"project_id"`
+	Position        int             `gorm:"not null" json:"position"`
+	Type            InvoiceLineType `gorm:"not null;default:time" json:"type"`
+	Description     string          `gorm:"not null" json:"description"`
+	Hours           float64         `gorm:"not null" json:"hours"`
+	Quantity        float64         `gorm:"not null;default:0" json:"quantity"`
+	Rate            float64         `gorm:"not null" json:"rate"`
+	Amount          float64         `gorm:"not null" json:"amount"`
+	ReleasedEntries int             `gorm:"not null" json:"released_entries"`
+	Project         Project         `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+}
+
+func (CreditNoteLine) TableName() string {
+	return "credit_note_lines"
+}
+
+func (l *CreditNoteLine) Units() float64 {
+	if l.Type == "" || l.Type == InvoiceLineTypeTime {
+		return l.Hours
+	}
+	return l.Quantity
+}
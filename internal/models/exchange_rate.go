@@ -0,0 +1,24 @@
+package models
+
+import "gorm.io/datatypes"
+
+type ExchangeRateSource string
+
+const (
+	ExchangeRateSourceManual ExchangeRateSource = "manual"
+	ExchangeRateSourceCSV    ExchangeRateSource = "csv"
+	ExchangeRateSourceECB    ExchangeRateSource = "ecb"
+)
+
+type ExchangeRate struct {
+	BaseModel
+	BaseCurrency  string             `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date" json:"base_currency"`
+	QuoteCurrency string             `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date" json:"quote_currency"`
+	Date          datatypes.Date     `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date" json:"date"`
+	Rate          float64            `gorm:"not null" json:"rate"`
+	Source        ExchangeRateSource `gorm:"not null" json:"source"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ExpenseCategory string
+
+const (
+	ExpenseCategoryTravel        ExpenseCategory = "travel"
+	ExpenseCategoryAccommodation ExpenseCategory = "accommodation"
+	ExpenseCategoryMeals         ExpenseCategory = "meals"
+	ExpenseCategorySoftware      ExpenseCategory = "software"
+	ExpenseCategoryEquipment     ExpenseCategory = "equipment"
+	ExpenseCategoryPerDiem       ExpenseCategory = "per_diem"
+	ExpenseCategoryOther         ExpenseCategory = "other"
+)
+
+type Expense struct {
+	BaseModel
+	ProjectID          uuid.UUID       `gorm:"not null;index" json:"project_id"`
+	UserID             uuid.UUID       `gorm:"not null" json:"user_id"`
+	Date               datatypes.Date  `gorm:"not null" json:"date"`
+	Amount             float64         `gorm:"not null" json:"amount"`
+	Currency           string          `gorm:"not null" json:"currency"`
+	Category           ExpenseCategory `gorm:"not null" json:"category"`
+	Description        string          `json:"description"`
+	IsBillable         bool            `gorm:"not null" json:"is_billable"`
+	MarkupPercent      float64         `gorm:"not null;default:0" json:"markup_percent"`
+	ReceiptFileName    string          `json:"receipt_file_name"`
+	ReceiptContentType string          `json:"receipt_content_type"`
+	ReceiptSize        int64           `json:"receipt_size"`
+	ReceiptChecksum    string          `json:"receipt_checksum"`
+	ReceiptStorageKey  string          `json:"-"`
+	InvoiceID          *uuid.UUID      `gorm:"index" json:"invoice_id,omitempty"`
+	InvoiceLineID      *uuid.UUID      `json:"invoice_line_id,omitempty"`
+	Project            Project         `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User               User            `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (Expense) TableName() string {
+	return "expenses"
+}
+
+func (e *Expense) IsLocked() bool {
+	return e.InvoiceID != nil
+}
+
+func (e *Expense) HasReceipt() bool {
+	return e.ReceiptStorageKey != ""
+}
+
+func (e *Expense) BillableAmount() float64 {
+	if !e.IsBillable {
+		return 0
+	}
+	return e.Amount + e.Amount*e.MarkupPercent/100
+}
+
+func IsValidExpenseCategory(category ExpenseCategory) bool {
+	switch category {
+	case ExpenseCategoryTravel, ExpenseCategoryAccommodation, ExpenseCategoryMeals,
+		ExpenseCategorySoftware, ExpenseCategoryEquipment, ExpenseCategoryPerDiem, ExpenseCategoryOther:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package models
+
+import "gorm.io/datatypes"
+
+type Holiday struct {
+	BaseModel
+	Date datatypes.Date `gorm:"not null;index" json:"date"`
+	Name string         `gorm:"not null" json:"name"`
+}
+
+func (Holiday) TableName() string {
+	return "holidays"
+}
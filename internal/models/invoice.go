@@ -0,0 +1,121 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type InvoiceStatus string
+
+const (
+	InvoiceStatusDraft    InvoiceStatus = "draft"
+	InvoiceStatusIssued   InvoiceStatus = "issued"
+	InvoiceStatusPaid     InvoiceStatus = "paid"
+	InvoiceStatusVoid     InvoiceStatus = "void"
+	InvoiceStatusCredited InvoiceStatus = "credited"
+)
+
+type InvoiceGrouping string
+
+const (
+	InvoiceGroupingProject  InvoiceGrouping = "project"
+	InvoiceGroupingActivity InvoiceGrouping = "activity"
+)
+
+type InvoiceLineType string
+
+const (
+	InvoiceLineTypeTime    InvoiceLineType = "time"
+	InvoiceLineTypeExpense InvoiceLineType = "expense"
+	InvoiceLineTypeMileage InvoiceLineType = "mileage"
+	InvoiceLineTypePerDiem InvoiceLineType = "per_diem"
+)
+
+type Invoice struct {
+	BaseModel
+	Number         string           `gorm:"index" json:"number"`
+	ClientID       uuid.UUID        `gorm:"not null;index" json:"client_id"`
+	UserID         uuid.UUID        `gorm:"not null" json:"user_id"`
+	Status         InvoiceStatus    `gorm:"not null" json:"status"`
+	Grouping       InvoiceGrouping  `gorm:"not null" json:"grouping"`
+	Currency       string           `gorm:"not null" json:"currency"`
+	PeriodStart    datatypes.Date   `gorm:"not null" json:"period_start"`
+	PeriodEnd      datatypes.Date   `gorm:"not null" json:"period_end"`
+	IssueDate      *datatypes.Date  `json:"issue_date"`
+	DueDate        *datatypes.Date  `json:"due_date"`
+	PaidAt         *time.Time       `json:"paid_at"`
+	VoidedAt       *time.Time       `json:"voided_at"`
+	TotalHours     float64          `gorm:"not null" json:"total_hours"`
+	Subtotal       float64          `gorm:"not null" json:"subtotal"`
+	TaxTotal       float64          `gorm:"not null" json:"tax_total"`
+	Total          float64          `gorm:"not null" json:"total"`
+	AmountPaid     float64          `gorm:"not null" json:"amount_paid"`
+	AmountCredited float64          `gorm:"not null" json:"amount_credited"`
+	ClientTaxID    string           `json:"client_tax_id"`
+	TaxNote        string           `json:"tax_note"`
+	Notes          string           `json:"notes"`
+	Client         Client           `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	User           User             `gorm:"foreignKey:UserID" json:"-"`
+	Lines          []InvoiceLine    `gorm:"foreignKey:InvoiceID" json:"lines,omitempty"`
+	TaxLines       []InvoiceTaxLine `gorm:"foreignKey:InvoiceID" json:"tax_lines,omitempty"`
+}
+
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+type InvoiceLine struct {
+	BaseModel
+	InvoiceID        uuid.UUID       `gorm:"not null;index" json:"invoice_id"`
+	ProjectID        uuid.UUID       `gorm:"not null" json:"project_id"`
+	Position         int             `gorm:"not null" json:"position"`
+	Type             InvoiceLineType `gorm:"not null;default:time" json:"type"`
+	Description      string          `gorm:"not null" json:"description"`
+	Hours            float64         `gorm:"not null" json:"hours"`
+	WrittenDownHours float64         `gorm:"not null" json:"written_down_hours"`
+	Quantity         float64         `gorm:"not null;default:0" json:"quantity"`
+	Unit             string          `json:"unit,omitempty"`
+	Rate             float64         `gorm:"not null" json:"rate"`
+	Amount           float64         `gorm:"not null" json:"amount"`
+	Project          Project         `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+}
+
+func (InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+func (l *InvoiceLine) IsTime() bool {
+	return l.Type == "" || l.Type == InvoiceLineTypeTime
+}
+
+func (l *InvoiceLine) Units() float64 {
+	if l.IsTime() {
+		return l.Hours
+	}
+	return l.Quantity
+}
+
+type InvoiceTaxLine struct {
+	BaseModel
+	InvoiceID     uuid.UUID `gorm:"not null;index" json:"invoice_id"`
+	Country       string    `gorm:"not null;index" json:"country"`
+	Name          string    `gorm:"not null" json:"name"`
+	Treatment     TaxStatus `gorm:"not null" json:"treatment"`
+	Rate          float64   `gorm:"not null" json:"rate"`
+	TaxableAmount float64   `gorm:"not null" json:"taxable_amount"`
+	Amount        float64   `gorm:"not null" json:"amount"`
+}
+
+func (InvoiceTaxLine) TableName() string {
+	return "invoice_tax_lines"
+}
+
+func (i *Invoice) IsEditable() bool {
+	return i.Status == InvoiceStatusDraft
+}
+
+func (i *Invoice) BalanceDue() float64 {
+	return i.Total - i.AmountPaid - i.AmountCredited
+}
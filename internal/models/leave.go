@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type LeaveType string
+
+const (
+	LeaveTypeVacation LeaveType = "vacation"
+	LeaveTypeSick     LeaveType = "sick"
+	LeaveTypePersonal LeaveType = "personal"
+	LeaveTypeOther    LeaveType = "other"
+)
+
+type Leave struct {
+	BaseModel
+	UserID    uuid.UUID      `gorm:"not null;index" json:"user_id"`
+	StartDate datatypes.Date `gorm:"not null;index" json:"start_date"`
+	EndDate   datatypes.Date `gorm:"not null;index" json:"end_date"`
+	Type      LeaveType      `gorm:"not null" json:"type"`
+	Notes     string         `json:"notes"`
+	User      User           `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (Leave) TableName() string {
+	return "leaves"
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationTypeBudgetThreshold NotificationType = "budget_threshold"
+)
+
+type Notification struct {
+	BaseModel
+	UserID     uuid.UUID        `gorm:"not null;index" json:"user_id"`
+	Type       NotificationType `gorm:"not null" json:"type"`
+	Title      string           `gorm:"not null" json:"title"`
+	Message    string           `json:"message"`
+	EntityType string           `json:"entity_type"`
+	EntityID   *uuid.UUID       `json:"entity_id"`
+	ReadAt     *time.Time       `json:"read_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
@@ -0,0 +1,14 @@
+package models
+
+import "github.com/google/uuid"
+
+type NumberSequence struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
+	UserID    uuid.UUID `gorm:"not null;uniqueIndex:idx_number_sequences_user_name" json:"user_id"`
+	Name      string    `gorm:"not null;uniqueIndex:idx_number_sequences_user_name" json:"name"`
+	LastValue int       `gorm:"not null" json:"last_value"`
+}
+
+func (NumberSequence) TableName() string {
+	return "number_sequences"
+}
@@ -0,0 +1,44 @@
+package models
+
+import "github.com/google/uuid"
+
+type OvertimeRule struct {
+	BaseModel
+	Name               string     `gorm:"not null" json:"name"`
+	DailyThreshold     float64    `gorm:"not null" json:"daily_threshold"`
+	WeeklyThreshold    float64    `gorm:"not null" json:"weekly_threshold"`
+	OvertimeMultiplier float64    `gorm:"not null" json:"overtime_multiplier"`
+	WeekendMultiplier  float64    `gorm:"not null" json:"weekend_multiplier"`
+	HolidayMultiplier  float64    `gorm:"not null" json:"holiday_multiplier"`
+	IsActive           bool       `gorm:"default:true" json:"is_active"`
+	ClientID           *uuid.UUID `json:"client_id"`
+	UserID             uuid.UUID  `gorm:"not null" json:"user_id"`
+	Client             *Client    `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	User               User       `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (OvertimeRule) TableName() string {
+	return "overtime_rules"
+}
+
+func DefaultOvertimeRule() OvertimeRule {
+	return OvertimeRule{
+		Name:               "Default",
+		DailyThreshold:     8,
+		WeeklyThreshold:    40,
+		OvertimeMultiplier: 1.5,
+		WeekendMultiplier:  1.5,
+		HolidayMultiplier:  2,
+		IsActive:           true,
+	}
+}
+
+func FlatRateRule() OvertimeRule {
+	return OvertimeRule{
+		Name:               "Standard",
+		OvertimeMultiplier: 1,
+		WeekendMultiplier:  1,
+		HolidayMultiplier:  1,
+		IsActive:           true,
+	}
+}
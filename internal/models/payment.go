@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type PaymentMethod string
+
+const (
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodCard         PaymentMethod = "card"
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodCheque       PaymentMethod = "cheque"
+	PaymentMethodCredit       PaymentMethod = "credit"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+type Payment struct {
+	BaseModel
+	UserID        uuid.UUID      `gorm:"not null;index" json:"user_id"`
+	ClientID      uuid.UUID      `gorm:"not null;index" json:"client_id"`
+	InvoiceID     uuid.UUID      `gorm:"not null;index" json:"invoice_id"`
+	PaymentDate   datatypes.Date `gorm:"not null" json:"payment_date"`
+	Currency      string         `gorm:"not null" json:"currency"`
+	Amount        float64        `gorm:"not null" json:"amount"`
+	AppliedAmount float64        `gorm:"not null" json:"applied_amount"`
+	Method        PaymentMethod  `gorm:"not null" json:"method"`
+	Reference     string         `json:"reference"`
+	Notes         string         `json:"notes"`
+	Invoice       Invoice        `gorm:"foreignKey:InvoiceID" json:"-"`
+	Client        Client         `gorm:"foreignKey:ClientID" json:"-"`
+}
+
+func (Payment) TableName() string {
+	return "payments"
+}
+
+func (p *Payment) CreditAmount() float64 {
+	if p.Method == PaymentMethodCredit {
+		return 0
+	}
+	return p.Amount - p.AppliedAmount
+}
+
+type ClientCredit struct {
+	BaseModel
+	UserID       uuid.UUID      `gorm:"not null;index" json:"user_id"`
+	ClientID     uuid.UUID      `gorm:"not null;index" json:"client_id"`
+	PaymentID    *uuid.UUID     `gorm:"index" json:"payment_id,omitempty"`
+	CreditNoteID *uuid.UUID     `gorm:"index" json:"credit_note_id,omitempty"`
+	Currency     string         `gorm:"not null" json:"currency"`
+	Amount       float64        `gorm:"not null" json:"amount"`
+	Date         datatypes.Date `gorm:"not null" json:"date"`
+	Description  string         `json:"description"`
+}
+
+func (ClientCredit) TableName() string {
+	return "client_credits"
+}
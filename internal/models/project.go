@@ -14,21 +14,43 @@ const (
 	ProjectStatusCancelled ProjectStatus = "cancelled"
 )
 
+type BillingType string
+
+const (
+	BillingTypeHourly   BillingType = "hourly"
+	BillingTypeFixedFee BillingType = "fixed_fee"
+	BillingTypeRetainer BillingType = "retainer"
+)
+
+type RolloverPolicy string
+
+const (
+	RolloverPolicyNone  RolloverPolicy = "none"
+	RolloverPolicyCarry RolloverPolicy = "carry_forward"
+)
+
 type Project struct {
 	BaseModel
-	Name         string          `gorm:"not null" json:"name"`
-	Code         string          `gorm:"uniqueIndex;not null" json:"code"`
-	Description  string          `json:"description"`
-	Status       ProjectStatus   `gorm:"default:'active'" json:"status"`
-	BillableRate float64         `gorm:"not null" json:"billable_rate"`
-	Currency     string          `gorm:"default:'USD'" json:"currency"`
-	StartDate    datatypes.Date  `json:"start_date"`
-	EndDate      *datatypes.Date `json:"end_date"`
-	IsActive     bool            `gorm:"default:true" json:"is_active"`
-	ClientID     uuid.UUID       `gorm:"not null" json:"client_id"`
-	UserID       uuid.UUID       `gorm:"not null" json:"user_id"`
-	Client       Client          `gorm:"foreignKey:ClientID" json:"client,omitempty"`
-	User         User            `gorm:"foreignKey:UserID" json:"-"`
-	Allocations  []Allocation    `gorm:"foreignKey:ProjectID" json:"allocations,omitempty"`
-	TimeEntries  []TimeEntry     `gorm:"foreignKey:ProjectID" json:"time_entries,omitempty"`
+	Name           string          `gorm:"not null" json:"name"`
+	Code           string          `gorm:"uniqueIndex;not null" json:"code"`
+	Description    string          `json:"description"`
+	Status         ProjectStatus   `gorm:"default:'active'" json:"status"`
+	BillableRate   float64         `gorm:"not null" json:"billable_rate"`
+	Currency       string          `gorm:"default:'USD'" json:"currency"`
+	BillingType    BillingType     `gorm:"not null;default:'hourly'" json:"billing_type"`
+	FixedFee       float64         `gorm:"not null" json:"fixed_fee"`
+	RetainerFee    float64         `gorm:"not null" json:"retainer_fee"`
+	RetainerHours  float64         `gorm:"not null" json:"retainer_hours"`
+	OverageRate    float64         `gorm:"not null" json:"overage_rate"`
+	RolloverPolicy RolloverPolicy  `gorm:"not null;default:'none'" json:"rollover_policy"`
+	RolloverCap    float64         `gorm:"not null" json:"rollover_cap"`
+	StartDate      datatypes.Date  `json:"start_date"`
+	EndDate        *datatypes.Date `json:"end_date"`
+	IsActive       bool            `gorm:"default:true" json:"is_active"`
+	ClientID       uuid.UUID       `gorm:"not null" json:"client_id"`
+	UserID         uuid.UUID       `gorm:"not null" json:"user_id"`
+	Client         Client          `gorm:"foreignKey:ClientID" json:"client,omitempty"`
+	User           User            `gorm:"foreignKey:UserID" json:"-"`
+	Allocations    []Allocation    `gorm:"foreignKey:ProjectID" json:"allocations,omitempty"`
+	TimeEntries    []TimeEntry     `gorm:"foreignKey:ProjectID" json:"time_entries,omitempty"`
 }
@@ -0,0 +1,15 @@
+package models
+
+import "github.com/google/uuid"
+
+type ProjectMember struct {
+	BaseModel
+	ProjectID uuid.UUID `gorm:"not null;uniqueIndex:idx_project_members_user" json:"project_id"`
+	UserID    uuid.UUID `gorm:"not null;uniqueIndex:idx_project_members_user;index" json:"user_id"`
+	Project   Project   `gorm:"foreignKey:ProjectID" json:"-"`
+	User      User      `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (ProjectMember) TableName() string {
+	return "project_members"
+}
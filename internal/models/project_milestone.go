@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ProjectMilestone struct {
+	BaseModel
+	ProjectID   uuid.UUID       `gorm:"not null;index" json:"project_id"`
+	Name        string          `gorm:"not null" json:"name"`
+	Amount      float64         `gorm:"not null" json:"amount"`
+	DueDate     *datatypes.Date `json:"due_date"`
+	CompletedOn *datatypes.Date `json:"completed_on"`
+}
+
+func (ProjectMilestone) TableName() string {
+	return "project_milestones"
+}
+
+func (m *ProjectMilestone) IsCompleted() bool {
+	return m.CompletedOn != nil
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ProjectRate struct {
+	BaseModel
+	ProjectID     uuid.UUID      `gorm:"not null;uniqueIndex:idx_project_rates_effective" json:"project_id"`
+	Rate          float64        `gorm:"not null" json:"rate"`
+	EffectiveFrom datatypes.Date `gorm:"not null;uniqueIndex:idx_project_rates_effective" json:"effective_from"`
+}
+
+func (ProjectRate) TableName() string {
+	return "project_rates"
+}
+
+type ProjectMemberRate struct {
+	BaseModel
+	ProjectID     uuid.UUID      `gorm:"not null;uniqueIndex:idx_project_member_rates_effective" json:"project_id"`
+	UserID        uuid.UUID      `gorm:"not null;uniqueIndex:idx_project_member_rates_effective" json:"user_id"`
+	Rate          float64        `gorm:"not null" json:"rate"`
+	EffectiveFrom datatypes.Date `gorm:"not null;uniqueIndex:idx_project_member_rates_effective" json:"effective_from"`
+	User          User           `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (ProjectMemberRate) TableName() string {
+	return "project_member_rates"
+}
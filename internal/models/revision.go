@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type RevisionAction string
+
+const (
+	RevisionActionCreate  RevisionAction = "create"
+	RevisionActionUpdate  RevisionAction = "update"
+	RevisionActionDelete  RevisionAction = "delete"
+	RevisionActionRestore RevisionAction = "restore"
+	RevisionActionPurge   RevisionAction = "purge"
+)
+
+const (
+	RevisionEntityTimeEntry  = "time_entry"
+	RevisionEntityAllocation = "allocation"
+)
+
+var ErrRevisionImmutable = errors.New("revisions are append-only")
+
+type Revision struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key" json:"id"`
+	EntityType string         `gorm:"not null;index:idx_revisions_entity" json:"entity_type"`
+	EntityID   uuid.UUID      `gorm:"not null;index:idx_revisions_entity" json:"entity_id"`
+	Action     RevisionAction `gorm:"not null" json:"action"`
+	UserID     uuid.UUID      `gorm:"not null" json:"user_id"`
+	Before     datatypes.JSON `json:"before"`
+	After      datatypes.JSON `json:"after"`
+	Override   bool           `gorm:"not null;index" json:"override"`
+	Reason     string         `json:"reason"`
+	CreatedAt  time.Time      `json:"created_at"`
+	User       User           `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (Revision) TableName() string {
+	return "revisions"
+}
+
+func (r *Revision) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+func (r *Revision) BeforeUpdate(tx *gorm.DB) error {
+	return ErrRevisionImmutable
+}
+
+func (r *Revision) BeforeDelete(tx *gorm.DB) error {
+	return ErrRevisionImmutable
+}
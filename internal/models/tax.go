@@ -0,0 +1,23 @@
+package models
+
+import "gorm.io/datatypes"
+
+type TaxStatus string
+
+const (
+	TaxStatusStandard      TaxStatus = "standard"
+	TaxStatusExempt        TaxStatus = "exempt"
+	TaxStatusReverseCharge TaxStatus = "reverse_charge"
+)
+
+type TaxRate struct {
+	BaseModel
+	Country       string         `gorm:"not null;index:idx_tax_rates_lookup" json:"country"`
+	Name          string         `gorm:"not null" json:"name"`
+	Rate          float64        `gorm:"not null" json:"rate"`
+	EffectiveFrom datatypes.Date `gorm:"not null;index:idx_tax_rates_lookup" json:"effective_from"`
+}
+
+func (TaxRate) TableName() string {
+	return "tax_rates"
+}
@@ -9,20 +9,36 @@ import (
 
 type TimeEntry struct {
 	BaseModel
-	ProjectID   uuid.UUID      `gorm:"not null" json:"project_id"`
-	UserID      uuid.UUID      `gorm:"not null" json:"user_id"`
-	Date        datatypes.Date `gorm:"not null" json:"date"`
-	Hours       float64        `gorm:"not null" json:"hours"`
-	Description string         `json:"description"`
-	IsBillable  bool           `gorm:"default:true" json:"is_billable"`
-	Project     Project        `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
-	User        User           `gorm:"foreignKey:UserID" json:"-"`
+	ProjectID        uuid.UUID      `gorm:"not null" json:"project_id"`
+	UserID           uuid.UUID      `gorm:"not null" json:"user_id"`
+	Date             datatypes.Date `gorm:"not null" json:"date"`
+	Hours            float64        `gorm:"not null" json:"hours"`
+	Description      string         `json:"description"`
+	IsBillable       bool           `gorm:"not null" json:"is_billable"`
+	WrittenDownHours float64        `gorm:"not null" json:"written_down_hours"`
+	CreditedHours    float64        `gorm:"not null;default:0" json:"credited_hours"`
+	InvoiceID        *uuid.UUID     `gorm:"index" json:"invoice_id,omitempty"`
+	InvoiceLineID    *uuid.UUID     `json:"invoice_line_id,omitempty"`
+	Project          Project        `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User             User           `gorm:"foreignKey:UserID" json:"-"`
+	Attachments      []Attachment   `gorm:"foreignKey:TimeEntryID" json:"attachments,omitempty"`
 }
 
 func (TimeEntry) TableName() string {
 	return "time_entries"
 }
 
+func (t *TimeEntry) IsLocked() bool {
+	return t.InvoiceID != nil
+}
+
+func (t *TimeEntry) BillableHours() float64 {
+	if !t.IsBillable {
+		return 0
+	}
+	return t.Hours - t.WrittenDownHours
+}
+
 type TimeEntryKey struct {
 	ProjectID uuid.UUID
 	UserID    uuid.UUID
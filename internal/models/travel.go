@@ -0,0 +1,108 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type VehicleType string
+
+const (
+	VehicleTypeCar         VehicleType = "car"
+	VehicleTypeElectricCar VehicleType = "electric_car"
+	VehicleTypeMotorcycle  VehicleType = "motorcycle"
+	VehicleTypeBicycle     VehicleType = "bicycle"
+)
+
+type MileageRate struct {
+	BaseModel
+	VehicleType   VehicleType    `gorm:"not null;index:idx_mileage_rates_lookup" json:"vehicle_type"`
+	Currency      string         `gorm:"not null;index:idx_mileage_rates_lookup" json:"currency"`
+	RatePerUnit   float64        `gorm:"not null" json:"rate_per_unit"`
+	DistanceUnit  string         `gorm:"not null" json:"distance_unit"`
+	EffectiveFrom datatypes.Date `gorm:"not null" json:"effective_from"`
+	Version       int            `gorm:"not null" json:"version"`
+}
+
+func (MileageRate) TableName() string {
+	return "mileage_rates"
+}
+
+type PerDiemRate struct {
+	BaseModel
+	Country       string         `gorm:"not null;index:idx_per_diem_rates_lookup" json:"country"`
+	Currency      string         `gorm:"not null;index:idx_per_diem_rates_lookup" json:"currency"`
+	DailyRate     float64        `gorm:"not null" json:"daily_rate"`
+	EffectiveFrom datatypes.Date `gorm:"not null" json:"effective_from"`
+	Version       int            `gorm:"not null" json:"version"`
+}
+
+func (PerDiemRate) TableName() string {
+	return "per_diem_rates"
+}
+
+type MileageEntry struct {
+	BaseModel
+	ProjectID     uuid.UUID      `gorm:"not null;index" json:"project_id"`
+	UserID        uuid.UUID      `gorm:"not null" json:"user_id"`
+	Date          datatypes.Date `gorm:"not null" json:"date"`
+	FromLocation  string         `gorm:"not null" json:"from_location"`
+	ToLocation    string         `gorm:"not null" json:"to_location"`
+	Distance      float64        `gorm:"not null" json:"distance"`
+	VehicleType   VehicleType    `gorm:"not null" json:"vehicle_type"`
+	Description   string         `json:"description"`
+	IsBillable    bool           `gorm:"not null" json:"is_billable"`
+	RateID        uuid.UUID      `gorm:"not null" json:"rate_id"`
+	RatePerUnit   float64        `gorm:"not null" json:"rate_per_unit"`
+	Currency      string         `gorm:"not null" json:"currency"`
+	Amount        float64        `gorm:"not null" json:"amount"`
+	InvoiceID     *uuid.UUID     `gorm:"index" json:"invoice_id,omitempty"`
+	InvoiceLineID *uuid.UUID     `json:"invoice_line_id,omitempty"`
+	Project       Project        `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User          User           `gorm:"foreignKey:UserID" json:"-"`
+	Rate          MileageRate    `gorm:"foreignKey:RateID" json:"rate,omitempty"`
+}
+
+func (MileageEntry) TableName() string {
+	return "mileage_entries"
+}
+
+type PerDiemClaim struct {
+	BaseModel
+	ProjectID     uuid.UUID      `gorm:"not null;index" json:"project_id"`
+	UserID        uuid.UUID      `gorm:"not null" json:"user_id"`
+	Date          datatypes.Date `gorm:"not null" json:"date"`
+	Days          float64        `gorm:"not null" json:"days"`
+	Country       string         `gorm:"not null" json:"country"`
+	Description   string         `json:"description"`
+	IsBillable    bool           `gorm:"not null" json:"is_billable"`
+	RateID        uuid.UUID      `gorm:"not null" json:"rate_id"`
+	DailyRate     float64        `gorm:"not null" json:"daily_rate"`
+	Currency      string         `gorm:"not null" json:"currency"`
+	Amount        float64        `gorm:"not null" json:"amount"`
+	InvoiceID     *uuid.UUID     `gorm:"index" json:"invoice_id,omitempty"`
+	InvoiceLineID *uuid.UUID     `json:"invoice_line_id,omitempty"`
+	Project       Project        `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User          User           `gorm:"foreignKey:UserID" json:"-"`
+	Rate          PerDiemRate    `gorm:"foreignKey:RateID" json:"rate,omitempty"`
+}
+
+func (PerDiemClaim) TableName() string {
+	return "per_diem_claims"
+}
+
+func (m *MileageEntry) IsLocked() bool {
+	return m.InvoiceID != nil
+}
+
+func (p *PerDiemClaim) IsLocked() bool {
+	return p.InvoiceID != nil
+}
+
+func IsValidVehicleType(vehicleType VehicleType) bool {
+	switch vehicleType {
+	case VehicleTypeCar, VehicleTypeElectricCar, VehicleTypeMotorcycle, VehicleTypeBicycle:
+		return true
+	}
+	return false
+}
@@ -7,11 +7,16 @@ import (
 
 type User struct {
 	BaseModel
-	Username string `gorm:"uniqueIndex;not null" json:"username"`
-	Password string `gorm:"not null" json:"-"`
-	Email    string `gorm:"uniqueIndex;not null" json:"email"`
-	FullName string `json:"full_name"`
-	IsActive bool   `gorm:"default:true" json:"is_active"`
+	Username          string  `gorm:"uniqueIndex;not null" json:"username"`
+	Password          string  `gorm:"not null" json:"-"`
+	Email             string  `gorm:"uniqueIndex;not null" json:"email"`
+	FullName          string  `json:"full_name"`
+	IsActive          bool    `gorm:"default:true" json:"is_active"`
+	IsAdmin           bool    `gorm:"not null" json:"is_admin"`
+	WeeklyCapacity    float64 `gorm:"not null;default:40" json:"weekly_capacity"`
+	ReportingCurrency string  `json:"reporting_currency"`
+	TimeZone          string  `json:"time_zone"`
+	UtilizationTarget float64 `gorm:"not null;default:75" json:"utilization_target"`
 }
 
 func (u *User) BeforeCreate(tx *gorm.DB) error {
@@ -29,6 +34,10 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+func (u *User) DailyCapacity() float64 {
+	return u.WeeklyCapacity / 5
+}
+
 func (u *User) CheckPassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
 	return err == nil
@@ -0,0 +1,29 @@
+package models
+
+import "github.com/google/uuid"
+
+type WriteDownReason string
+
+const (
+	WriteDownReasonGoodwill     WriteDownReason = "goodwill"
+	WriteDownReasonInefficiency WriteDownReason = "inefficiency"
+	WriteDownReasonScopeDispute WriteDownReason = "scope_dispute"
+	WriteDownReasonRateDispute  WriteDownReason = "rate_dispute"
+	WriteDownReasonTraining     WriteDownReason = "training"
+	WriteDownReasonOther        WriteDownReason = "other"
+)
+
+type WriteDown struct {
+	BaseModel
+	UserID        uuid.UUID       `gorm:"not null;index" json:"user_id"`
+	TimeEntryID   *uuid.UUID      `gorm:"index" json:"time_entry_id,omitempty"`
+	InvoiceID     *uuid.UUID      `gorm:"index" json:"invoice_id,omitempty"`
+	InvoiceLineID *uuid.UUID      `gorm:"index" json:"invoice_line_id,omitempty"`
+	Hours         float64         `gorm:"not null" json:"hours"`
+	Reason        WriteDownReason `gorm:"not null" json:"reason"`
+	Notes         string          `json:"notes"`
+}
+
+func (WriteDown) TableName() string {
+	return "write_downs"
+}
@@ -0,0 +1,272 @@
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Document struct {
+	title  string
+	pages  []*Page
+	images []*Image
+}
+
+type Page struct {
+	content bytes.Buffer
+	images  map[*Image]bool
+}
+
+type Image struct {
+	Width  int
+	Height int
+	data   []byte
+	index  int
+}
+
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+func (d *Document) AddPage() *Page {
+	page := &Page{images: make(map[*Image]bool)}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+func (d *Document) Pages() []*Page {
+	return d.pages
+}
+
+// LoadImage decodes a PNG or JPEG image and stores it as flattened RGB so it
+// can be placed on any page of the document.
+func (d *Document) LoadImage(r io.Reader) (*Image, error) {
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	var raw bytes.Buffer
+	writer := zlib.NewWriter(&raw)
+	row := make([]byte, 0, bounds.Dx()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row = row[:0]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(src.At(x, y)).(color.NRGBA)
+			alpha := int(c.A)
+			row = append(row,
+				byte((int(c.R)*alpha+255*(255-alpha))/255),
+				byte((int(c.G)*alpha+255*(255-alpha))/255),
+				byte((int(c.B)*alpha+255*(255-alpha))/255),
+			)
+		}
+		if _, err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	img := &Image{
+		Width:  bounds.Dx(),
+		Height: bounds.Dy(),
+		data:   raw.Bytes(),
+		index:  len(d.images) + 1,
+	}
+	d.images = append(d.images, img)
+	return img, nil
+}
+
+// Coordinates passed to Page methods are measured in points from the top-left
+// corner of the page; text is positioned by its baseline.
+
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font.resourceName(), size, x, PageHeight-y, escapeText(s))
+}
+
+func (p *Page) TextRight(right, y float64, font Font, size float64, s string) {
+	p.Text(right-TextWidth(s, font, size), y, font, size, s)
+}
+
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%.2f w %.2f %.2f m %.2f %.2f l S\n",
+		width, x1, PageHeight-y1, x2, PageHeight-y2)
+}
+
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "q %.3f g %.2f %.2f %.2f %.2f re f Q\n",
+		gray, x, PageHeight-y-h, w, h)
+}
+
+func (p *Page) Image(img *Image, x, y, w, h float64) {
+	p.images[img] = true
+	fmt.Fprintf(&p.content, "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n",
+		w, h, x, PageHeight-y-h, img.index)
+}
+
+// Wrap splits s into lines no wider than maxWidth, breaking on spaces where
+// possible.
+func Wrap(s string, font Font, size, maxWidth float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(s, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		current := ""
+		for _, word := range words {
+			candidate := word
+			if current != "" {
+				candidate = current + " " + word
+			}
+			if TextWidth(candidate, font, size) <= maxWidth || current == "" {
+				current = candidate
+				continue
+			}
+			lines = append(lines, current)
+			current = word
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	out := &countingWriter{w: bufio.NewWriter(w)}
+	var offsets []int64
+
+	begin := func() int {
+		offsets = append(offsets, out.n)
+		id := len(offsets)
+		fmt.Fprintf(out, "%d 0 obj\n", id)
+		return id
+	}
+	end := func() {
+		fmt.Fprint(out, "endobj\n")
+	}
+	stream := func(dict string, data []byte) {
+		fmt.Fprintf(out, "<< %s /Length %d >>\nstream\n", dict, len(data))
+		out.Write(data)
+		fmt.Fprint(out, "\nendstream\n")
+	}
+
+	fmt.Fprint(out, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	pageCount := len(d.pages)
+	imageBase := 6
+	pageBase := imageBase + len(d.images)
+
+	begin()
+	fmt.Fprint(out, "<< /Type /Catalog /Pages 2 0 R >>\n")
+	end()
+
+	begin()
+	kids := make([]string, pageCount)
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", pageBase+i*2)
+	}
+	fmt.Fprintf(out, "<< /Type /Pages /Kids [%s] /Count %d >>\n", strings.Join(kids, " "), pageCount)
+	end()
+
+	for _, font := range []Font{Helvetica, HelveticaBold} {
+		begin()
+		fmt.Fprintf(out, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>\n", font.baseFont())
+		end()
+	}
+
+	begin()
+	fmt.Fprintf(out, "<< /Title (%s) /Producer (consultant-time-tracker) >>\n", escapeText(d.title))
+	end()
+
+	for _, img := range d.images {
+		begin()
+		stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode",
+			img.Width, img.Height), img.data)
+		end()
+	}
+
+	for i, page := range d.pages {
+		var xobjects strings.Builder
+		for _, img := range d.images {
+			if page.images[img] {
+				fmt.Fprintf(&xobjects, " /Im%d %d 0 R", img.index, imageBase+img.index-1)
+			}
+		}
+
+		begin()
+		fmt.Fprintf(out, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Contents %d 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> /XObject <<%s >> >> >>\n",
+			PageWidth, PageHeight, pageBase+i*2+1, xobjects.String())
+		end()
+
+		var compressed bytes.Buffer
+		writer := zlib.NewWriter(&compressed)
+		writer.Write(page.content.Bytes())
+		writer.Close()
+
+		begin()
+		stream("/Filter /FlateDecode", compressed.Bytes())
+		end()
+	}
+
+	xref := out.n
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, 5, xref)
+
+	if out.err != nil {
+		return out.n, out.err
+	}
+	return out.n, out.w.Flush()
+}
+
+func escapeText(s string) string {
+	var escaped strings.Builder
+	for _, b := range encodeWinAnsi(s) {
+		switch b {
+		case '(', ')', '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteByte(b)
+		case '\r', '\n', '\t':
+			escaped.WriteByte(' ')
+		default:
+			escaped.WriteByte(b)
+		}
+	}
+	return escaped.String()
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
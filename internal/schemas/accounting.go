@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAccountMappingRequest struct {
+	ClientID          *uuid.UUID `json:"client_id"`
+	ProjectID         *uuid.UUID `json:"project_id"`
+	ReceivableAccount string     `json:"receivable_account" binding:"omitempty,max=50"`
+	RevenueAccount    string     `json:"revenue_account" binding:"omitempty,max=50"`
+	TaxAccount        string     `json:"tax_account" binding:"omitempty,max=50"`
+	BankAccount       string     `json:"bank_account" binding:"omitempty,max=50"`
+}
+
+type UpdateAccountMappingRequest struct {
+	ReceivableAccount *string `json:"receivable_account" binding:"omitempty,max=50"`
+	RevenueAccount    *string `json:"revenue_account" binding:"omitempty,max=50"`
+	TaxAccount        *string `json:"tax_account" binding:"omitempty,max=50"`
+	BankAccount       *string `json:"bank_account" binding:"omitempty,max=50"`
+}
+
+type AccountMappingResponse struct {
+	ID                uuid.UUID       `json:"id"`
+	ClientID          *uuid.UUID      `json:"client_id"`
+	Client            *ClientSummary  `json:"client,omitempty"`
+	ProjectID         *uuid.UUID      `json:"project_id"`
+	Project           *ProjectSummary `json:"project,omitempty"`
+	ReceivableAccount string          `json:"receivable_account"`
+	RevenueAccount    string          `json:"revenue_account"`
+	TaxAccount        string          `json:"tax_account"`
+	BankAccount       string          `json:"bank_account"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+type AccountMappingListResponse struct {
+	Mappings []AccountMappingResponse `json:"account_mappings"`
+	Defaults AccountMappingDefaults   `json:"defaults"`
+}
+
+type AccountMappingDefaults struct {
+	ReceivableAccount string `json:"receivable_account"`
+	RevenueAccount    string `json:"revenue_account"`
+	TaxAccount        string `json:"tax_account"`
+	BankAccount       string `json:"bank_account"`
+}
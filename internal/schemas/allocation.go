@@ -32,6 +32,7 @@ type AllocationResponse struct {
 	Notes        string          `json:"notes"`
 	CreatedAt    time.Time       `json:"created_at"`
 	UpdatedAt    time.Time       `json:"updated_at"`
+	DeletedAt    *time.Time      `json:"deleted_at,omitempty"`
 }
 
 type ProjectSummary struct {
@@ -0,0 +1,23 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentResponse struct {
+	ID          uuid.UUID `json:"id"`
+	TimeEntryID uuid.UUID `json:"time_entry_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type AttachmentListResponse struct {
+	TimeEntryID uuid.UUID            `json:"time_entry_id"`
+	Attachments []AttachmentResponse `json:"attachments"`
+	TotalSize   int64                `json:"total_size"`
+}
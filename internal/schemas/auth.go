@@ -14,12 +14,25 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type UpdateProfileRequest struct {
+	FullName          *string  `json:"full_name" binding:"omitempty,min=1,max=200"`
+	WeeklyCapacity    *float64 `json:"weekly_capacity" binding:"omitempty,min=0,max=168"`
+	ReportingCurrency *string  `json:"reporting_currency" binding:"omitempty,len=0|len=3"`
+	TimeZone          *string  `json:"time_zone" binding:"omitempty,max=64"`
+	UtilizationTarget *float64 `json:"utilization_target" binding:"omitempty,min=0,max=100"`
+}
+
 type UserResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Username string    `json:"username"`
-	Email    string    `json:"email"`
-	FullName string    `json:"full_name"`
-	IsActive bool      `json:"is_active"`
+	ID                uuid.UUID `json:"id"`
+	Username          string    `json:"username"`
+	Email             string    `json:"email"`
+	FullName          string    `json:"full_name"`
+	IsActive          bool      `json:"is_active"`
+	IsAdmin           bool      `json:"is_admin"`
+	WeeklyCapacity    float64   `json:"weekly_capacity"`
+	ReportingCurrency string    `json:"reporting_currency"`
+	TimeZone          string    `json:"time_zone"`
+	UtilizationTarget float64   `json:"utilization_target"`
 }
 
 type AuthResponse struct {
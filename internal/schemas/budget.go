@@ -0,0 +1,65 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateBudgetRequest struct {
+	Phase      string    `json:"phase" binding:"max=100"`
+	StartDate  *string   `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate    *string   `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	Hours      float64   `json:"hours" binding:"min=0"`
+	Amount     float64   `json:"amount" binding:"min=0"`
+	Thresholds []float64 `json:"thresholds"`
+}
+
+type UpdateBudgetRequest struct {
+	Phase      *string   `json:"phase" binding:"omitempty,max=100"`
+	StartDate  *string   `json:"start_date" binding:"omitempty,len=0|datetime=2006-01-02"`
+	EndDate    *string   `json:"end_date" binding:"omitempty,len=0|datetime=2006-01-02"`
+	Hours      *float64  `json:"hours" binding:"omitempty,min=0"`
+	Amount     *float64  `json:"amount" binding:"omitempty,min=0"`
+	Thresholds []float64 `json:"thresholds"`
+}
+
+type BudgetResponse struct {
+	ID         uuid.UUID `json:"id"`
+	ProjectID  uuid.UUID `json:"project_id"`
+	Phase      string    `json:"phase"`
+	StartDate  *string   `json:"start_date,omitempty"`
+	EndDate    *string   `json:"end_date,omitempty"`
+	Hours      float64   `json:"hours"`
+	Amount     float64   `json:"amount"`
+	Thresholds []float64 `json:"thresholds"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type BudgetListResponse struct {
+	ProjectID uuid.UUID        `json:"project_id"`
+	Budgets   []BudgetResponse `json:"budgets"`
+}
+
+type BudgetBurnResponse struct {
+	Budget          BudgetResponse `json:"budget"`
+	WindowStart     string         `json:"window_start"`
+	WindowEnd       *string        `json:"window_end,omitempty"`
+	LoggedHours     float64        `json:"logged_hours"`
+	LoggedValue     float64        `json:"logged_value"`
+	PlannedHours    float64        `json:"planned_hours"`
+	PlannedValue    float64        `json:"planned_value"`
+	RemainingHours  float64        `json:"remaining_hours"`
+	RemainingAmount float64        `json:"remaining_amount"`
+	ProjectedHours  float64        `json:"projected_hours"`
+	ProjectedValue  float64        `json:"projected_value"`
+	HoursPercent    float64        `json:"hours_percent"`
+	AmountPercent   float64        `json:"amount_percent"`
+}
+
+type ProjectBurnResponse struct {
+	Project ProjectSummary       `json:"project"`
+	AsOf    string               `json:"as_of"`
+	Budgets []BudgetBurnResponse `json:"budgets"`
+}
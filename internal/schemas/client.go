@@ -7,33 +7,52 @@ import (
 )
 
 type CreateClientRequest struct {
-	Name    string `json:"name" binding:"required,min=1,max=200"`
-	Code    string `json:"code" binding:"required,min=2,max=20,alphanum"`
-	Email   string `json:"email" binding:"omitempty,email"`
-	Phone   string `json:"phone" binding:"omitempty,max=50"`
-	Address string `json:"address" binding:"omitempty,max=500"`
+	Name           string  `json:"name" binding:"required,min=1,max=200"`
+	Code           string  `json:"code" binding:"required,min=2,max=20,alphanum"`
+	Email          string  `json:"email" binding:"omitempty,email"`
+	Phone          string  `json:"phone" binding:"omitempty,max=50"`
+	Address        string  `json:"address" binding:"omitempty,max=500"`
+	DefaultRate    float64 `json:"default_rate" binding:"min=0"`
+	TaxID          string  `json:"tax_id" binding:"omitempty,max=50"`
+	Country        string  `json:"country" binding:"omitempty,len=2,alpha"`
+	TaxStatus      string  `json:"tax_status" binding:"omitempty,oneof=standard exempt reverse_charge"`
+	PeppolID       string  `json:"peppol_id" binding:"omitempty,max=100"`
+	BuyerReference string  `json:"buyer_reference" binding:"omitempty,max=100"`
 }
 
 type UpdateClientRequest struct {
-	Name     *string `json:"name" binding:"omitempty,min=1,max=200"`
-	Code     *string `json:"code" binding:"omitempty,min=2,max=20,alphanum"`
-	Email    *string `json:"email" binding:"omitempty,email"`
-	Phone    *string `json:"phone" binding:"omitempty,max=50"`
-	Address  *string `json:"address" binding:"omitempty,max=500"`
-	IsActive *bool   `json:"is_active"`
+	Name           *string  `json:"name" binding:"omitempty,min=1,max=200"`
+	Code           *string  `json:"code" binding:"omitempty,min=2,max=20,alphanum"`
+	Email          *string  `json:"email" binding:"omitempty,email"`
+	Phone          *string  `json:"phone" binding:"omitempty,max=50"`
+	Address        *string  `json:"address" binding:"omitempty,max=500"`
+	DefaultRate    *float64 `json:"default_rate" binding:"omitempty,min=0"`
+	TaxID          *string  `json:"tax_id" binding:"omitempty,max=50"`
+	Country        *string  `json:"country" binding:"omitempty,len=0|len=2"`
+	TaxStatus      *string  `json:"tax_status" binding:"omitempty,oneof=standard exempt reverse_charge"`
+	PeppolID       *string  `json:"peppol_id" binding:"omitempty,max=100"`
+	BuyerReference *string  `json:"buyer_reference" binding:"omitempty,max=100"`
+	IsActive       *bool    `json:"is_active"`
 }
 
 type ClientResponse struct {
-	ID        uuid.UUID               `json:"id"`
-	Name      string                  `json:"name"`
-	Code      string                  `json:"code"`
-	Email     string                  `json:"email"`
-	Phone     string                  `json:"phone"`
-	Address   string                  `json:"address"`
-	IsActive  bool                    `json:"is_active"`
-	CreatedAt time.Time               `json:"created_at"`
-	UpdatedAt time.Time               `json:"updated_at"`
-	Projects  []ClientProjectResponse `json:"projects,omitempty"`
+	ID             uuid.UUID               `json:"id"`
+	Name           string                  `json:"name"`
+	Code           string                  `json:"code"`
+	Email          string                  `json:"email"`
+	Phone          string                  `json:"phone"`
+	Address        string                  `json:"address"`
+	DefaultRate    float64                 `json:"default_rate"`
+	TaxID          string                  `json:"tax_id"`
+	Country        string                  `json:"country"`
+	TaxStatus      string                  `json:"tax_status"`
+	PeppolID       string                  `json:"peppol_id"`
+	BuyerReference string                  `json:"buyer_reference"`
+	IsActive       bool                    `json:"is_active"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+	DeletedAt      *time.Time              `json:"deleted_at,omitempty"`
+	Projects       []ClientProjectResponse `json:"projects,omitempty"`
 }
 
 type ClientProjectResponse struct {
@@ -0,0 +1,29 @@
+package schemas
+
+type UpdateCompanyProfileRequest struct {
+	Name              *string `json:"name" binding:"omitempty,max=200"`
+	Address           *string `json:"address" binding:"omitempty,max=500"`
+	Email             *string `json:"email" binding:"omitempty,email"`
+	Phone             *string `json:"phone" binding:"omitempty,max=50"`
+	Website           *string `json:"website" binding:"omitempty,max=200"`
+	TaxID             *string `json:"tax_id" binding:"omitempty,max=50"`
+	Country           *string `json:"country" binding:"omitempty,len=0|len=2"`
+	PeppolID          *string `json:"peppol_id" binding:"omitempty,max=100"`
+	BankDetails       *string `json:"bank_details" binding:"omitempty,max=1000"`
+	ReportingCurrency *string `json:"reporting_currency" binding:"omitempty,len=0|len=3"`
+}
+
+type CompanyProfileResponse struct {
+	Name              string `json:"name"`
+	Address           string `json:"address"`
+	Email             string `json:"email"`
+	Phone             string `json:"phone"`
+	Website           string `json:"website"`
+	TaxID             string `json:"tax_id"`
+	Country           string `json:"country"`
+	PeppolID          string `json:"peppol_id"`
+	BankDetails       string `json:"bank_details"`
+	ReportingCurrency string `json:"reporting_currency"`
+	HasLogo           bool   `json:"has_logo"`
+	LogoFile          string `json:"logo_file_name,omitempty"`
+}
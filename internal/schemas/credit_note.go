@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreditNoteLineRequest struct {
+	InvoiceLineID uuid.UUID `json:"invoice_line_id" binding:"required"`
+	Hours         float64   `json:"hours" binding:"omitempty,gt=0"`
+	Quantity      float64   `json:"quantity" binding:"omitempty,gt=0"`
+}
+
+type CreateCreditNoteRequest struct {
+	Reason    string                  `json:"reason" binding:"required,max=1000"`
+	IssueDate *string                 `json:"issue_date" binding:"omitempty,datetime=2006-01-02"`
+	Lines     []CreditNoteLineRequest `json:"lines" binding:"omitempty,dive"`
+}
+
+type CreditNoteLineResponse struct {
+	ID              uuid.UUID `json:"id"`
+	Position        int       `json:"position"`
+	InvoiceLineID   uuid.UUID `json:"invoice_line_id"`
+	ProjectID       uuid.UUID `json:"project_id"`
+	Type            string    `json:"type"`
+	Description     string    `json:"description"`
+	Hours           float64   `json:"hours"`
+	Quantity        float64   `json:"quantity"`
+	Rate            float64   `json:"rate"`
+	Amount          float64   `json:"amount"`
+	ReleasedEntries int       `json:"released_entries"`
+}
+
+type CreditNoteResponse struct {
+	ID            uuid.UUID                `json:"id"`
+	Number        string                   `json:"number"`
+	InvoiceID     uuid.UUID                `json:"invoice_id"`
+	InvoiceNumber string                   `json:"invoice_number"`
+	ClientID      uuid.UUID                `json:"client_id"`
+	Client        *ClientSummary           `json:"client,omitempty"`
+	IssueDate     string                   `json:"issue_date"`
+	Currency      string                   `json:"currency"`
+	Reason        string                   `json:"reason"`
+	TotalHours    float64                  `json:"total_hours"`
+	Subtotal      float64                  `json:"subtotal"`
+	TaxTotal      float64                  `json:"tax_total"`
+	Total         float64                  `json:"total"`
+	AppliedAmount float64                  `json:"applied_amount"`
+	CreditAmount  float64                  `json:"credit_amount"`
+	Lines         []CreditNoteLineResponse `json:"lines,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+}
+
+type CreditNoteListResponse struct {
+	CreditNotes []CreditNoteResponse `json:"credit_notes"`
+	Total       int64                `json:"total"`
+	Offset      int                  `json:"offset"`
+	Limit       int                  `json:"limit"`
+}
@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SetExchangeRateRequest struct {
+	BaseCurrency  string  `json:"base_currency" binding:"required,len=3,alpha"`
+	QuoteCurrency string  `json:"quote_currency" binding:"required,len=3,alpha"`
+	Rate          float64 `json:"rate" binding:"required,gt=0"`
+	Date          string  `json:"date" binding:"required,datetime=2006-01-02"`
+}
+
+type ExchangeRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          float64   `json:"rate"`
+	Date          string    `json:"date"`
+	Source        string    `json:"source"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ExchangeRateListResponse struct {
+	Rates []ExchangeRateResponse `json:"rates"`
+}
+
+type ExchangeRateImportResponse struct {
+	Format   string `json:"format"`
+	Imported int    `json:"imported"`
+	Updated  int    `json:"updated"`
+}
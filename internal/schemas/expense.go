@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateExpenseRequest struct {
+	ProjectID     uuid.UUID `json:"project_id" binding:"required"`
+	Date          string    `json:"date" binding:"required,datetime=2006-01-02"`
+	Amount        float64   `json:"amount" binding:"required,gt=0"`
+	Currency      string    `json:"currency" binding:"omitempty,len=3"`
+	Category      string    `json:"category" binding:"required,oneof=travel accommodation meals software equipment per_diem other"`
+	Description   string    `json:"description" binding:"max=1000"`
+	IsBillable    *bool     `json:"is_billable"`
+	MarkupPercent float64   `json:"markup_percent" binding:"min=0,max=1000"`
+}
+
+type UpdateExpenseRequest struct {
+	Date          *string  `json:"date" binding:"omitempty,datetime=2006-01-02"`
+	Amount        *float64 `json:"amount" binding:"omitempty,gt=0"`
+	Currency      *string  `json:"currency" binding:"omitempty,len=3"`
+	Category      *string  `json:"category" binding:"omitempty,oneof=travel accommodation meals software equipment per_diem other"`
+	Description   *string  `json:"description" binding:"omitempty,max=1000"`
+	IsBillable    *bool    `json:"is_billable"`
+	MarkupPercent *float64 `json:"markup_percent" binding:"omitempty,min=0,max=1000"`
+}
+
+type ReceiptResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+}
+
+type ExpenseResponse struct {
+	ID             uuid.UUID        `json:"id"`
+	ProjectID      uuid.UUID        `json:"project_id"`
+	Project        *ProjectSummary  `json:"project,omitempty"`
+	Date           string           `json:"date"`
+	Amount         float64          `json:"amount"`
+	Currency       string           `json:"currency"`
+	Category       string           `json:"category"`
+	Description    string           `json:"description"`
+	IsBillable     bool             `json:"is_billable"`
+	MarkupPercent  float64          `json:"markup_percent"`
+	BillableAmount float64          `json:"billable_amount"`
+	InvoiceID      *uuid.UUID       `json:"invoice_id,omitempty"`
+	IsLocked       bool             `json:"is_locked"`
+	Receipt        *ReceiptResponse `json:"receipt,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+type ExpenseListResponse struct {
+	Expenses []ExpenseResponse `json:"expenses"`
+	Total    int64             `json:"total"`
+	Offset   int               `json:"offset"`
+	Limit    int               `json:"limit"`
+}
@@ -0,0 +1,23 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" binding:"required,datetime=2006-01-02"`
+	Name string `json:"name" binding:"required,min=1,max=200"`
+}
+
+type HolidayResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type HolidayListResponse struct {
+	Holidays []HolidayResponse `json:"holidays"`
+}
@@ -0,0 +1,86 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateInvoiceRequest struct {
+	ClientID  uuid.UUID `json:"client_id" binding:"required"`
+	StartDate string    `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate   string    `json:"end_date" binding:"required,datetime=2006-01-02"`
+	GroupBy   string    `json:"group_by" binding:"omitempty,oneof=project activity"`
+	Currency  string    `json:"currency" binding:"omitempty,len=3"`
+	Notes     string    `json:"notes" binding:"max=2000"`
+}
+
+type IssueInvoiceRequest struct {
+	IssueDate       *string `json:"issue_date" binding:"omitempty,datetime=2006-01-02"`
+	PaymentTermDays int     `json:"payment_term_days" binding:"omitempty,min=1,max=365"`
+}
+
+type PayInvoiceRequest struct {
+	PaidDate *string `json:"paid_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type InvoiceLineResponse struct {
+	ID               uuid.UUID       `json:"id"`
+	Position         int             `json:"position"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Project          *ProjectSummary `json:"project,omitempty"`
+	Type             string          `json:"type"`
+	Description      string          `json:"description"`
+	Hours            float64         `json:"hours"`
+	WrittenDownHours float64         `json:"written_down_hours"`
+	Quantity         float64         `json:"quantity"`
+	Unit             string          `json:"unit,omitempty"`
+	Rate             float64         `json:"rate"`
+	Amount           float64         `json:"amount"`
+}
+
+type InvoiceTaxLineResponse struct {
+	Country       string  `json:"country"`
+	Name          string  `json:"name"`
+	Treatment     string  `json:"treatment"`
+	Rate          float64 `json:"rate"`
+	TaxableAmount float64 `json:"taxable_amount"`
+	Amount        float64 `json:"amount"`
+}
+
+type InvoiceResponse struct {
+	ID             uuid.UUID                `json:"id"`
+	Number         string                   `json:"number"`
+	Status         string                   `json:"status"`
+	Grouping       string                   `json:"grouping"`
+	ClientID       uuid.UUID                `json:"client_id"`
+	Client         *ClientSummary           `json:"client,omitempty"`
+	Currency       string                   `json:"currency"`
+	PeriodStart    string                   `json:"period_start"`
+	PeriodEnd      string                   `json:"period_end"`
+	IssueDate      *string                  `json:"issue_date,omitempty"`
+	DueDate        *string                  `json:"due_date,omitempty"`
+	PaidAt         *time.Time               `json:"paid_at,omitempty"`
+	VoidedAt       *time.Time               `json:"voided_at,omitempty"`
+	TotalHours     float64                  `json:"total_hours"`
+	Subtotal       float64                  `json:"subtotal"`
+	TaxTotal       float64                  `json:"tax_total"`
+	Total          float64                  `json:"total"`
+	AmountPaid     float64                  `json:"amount_paid"`
+	AmountCredited float64                  `json:"amount_credited"`
+	BalanceDue     float64                  `json:"balance_due"`
+	ClientTaxID    string                   `json:"client_tax_id,omitempty"`
+	TaxNote        string                   `json:"tax_note,omitempty"`
+	TaxLines       []InvoiceTaxLineResponse `json:"tax_lines"`
+	Notes          string                   `json:"notes"`
+	Lines          []InvoiceLineResponse    `json:"lines,omitempty"`
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+type InvoiceListResponse struct {
+	Invoices []InvoiceResponse `json:"invoices"`
+	Total    int64             `json:"total"`
+	Offset   int               `json:"offset"`
+	Limit    int               `json:"limit"`
+}
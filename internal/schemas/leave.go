@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateLeaveRequest struct {
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"`
+	Type      string `json:"type" binding:"required,oneof=vacation sick personal other"`
+	Notes     string `json:"notes" binding:"max=500"`
+}
+
+type LeaveResponse struct {
+	ID        uuid.UUID `json:"id"`
+	StartDate string    `json:"start_date"`
+	EndDate   string    `json:"end_date"`
+	Type      string    `json:"type"`
+	Notes     string    `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LeaveListResponse struct {
+	Leave []LeaveResponse `json:"leave"`
+}
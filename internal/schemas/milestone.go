@@ -0,0 +1,38 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateMilestoneRequest struct {
+	Name        string  `json:"name" binding:"required,min=1,max=200"`
+	Amount      float64 `json:"amount" binding:"min=0"`
+	DueDate     *string `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
+	CompletedOn *string `json:"completed_on" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type UpdateMilestoneRequest struct {
+	Name        *string  `json:"name" binding:"omitempty,min=1,max=200"`
+	Amount      *float64 `json:"amount" binding:"omitempty,min=0"`
+	DueDate     *string  `json:"due_date" binding:"omitempty,len=0|datetime=2006-01-02"`
+	CompletedOn *string  `json:"completed_on" binding:"omitempty,len=0|datetime=2006-01-02"`
+}
+
+type MilestoneResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	Name        string    `json:"name"`
+	Amount      float64   `json:"amount"`
+	DueDate     *string   `json:"due_date,omitempty"`
+	CompletedOn *string   `json:"completed_on,omitempty"`
+	IsCompleted bool      `json:"is_completed"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type MilestoneListResponse struct {
+	ProjectID  uuid.UUID           `json:"project_id"`
+	Milestones []MilestoneResponse `json:"milestones"`
+}
@@ -0,0 +1,90 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateOvertimeRuleRequest struct {
+	Name               string     `json:"name" binding:"required,min=1,max=100"`
+	ClientID           *uuid.UUID `json:"client_id"`
+	DailyThreshold     *float64   `json:"daily_threshold" binding:"omitempty,min=0,max=24"`
+	WeeklyThreshold    *float64   `json:"weekly_threshold" binding:"omitempty,min=0,max=168"`
+	OvertimeMultiplier *float64   `json:"overtime_multiplier" binding:"omitempty,min=1,max=5"`
+	WeekendMultiplier  *float64   `json:"weekend_multiplier" binding:"omitempty,min=1,max=5"`
+	HolidayMultiplier  *float64   `json:"holiday_multiplier" binding:"omitempty,min=1,max=5"`
+}
+
+type UpdateOvertimeRuleRequest struct {
+	Name               *string  `json:"name" binding:"omitempty,min=1,max=100"`
+	DailyThreshold     *float64 `json:"daily_threshold" binding:"omitempty,min=0,max=24"`
+	WeeklyThreshold    *float64 `json:"weekly_threshold" binding:"omitempty,min=0,max=168"`
+	OvertimeMultiplier *float64 `json:"overtime_multiplier" binding:"omitempty,min=1,max=5"`
+	WeekendMultiplier  *float64 `json:"weekend_multiplier" binding:"omitempty,min=1,max=5"`
+	HolidayMultiplier  *float64 `json:"holiday_multiplier" binding:"omitempty,min=1,max=5"`
+	IsActive           *bool    `json:"is_active"`
+}
+
+type OvertimeRuleResponse struct {
+	ID                 uuid.UUID      `json:"id"`
+	Name               string         `json:"name"`
+	ClientID           *uuid.UUID     `json:"client_id"`
+	Client             *ClientSummary `json:"client,omitempty"`
+	DailyThreshold     float64        `json:"daily_threshold"`
+	WeeklyThreshold    float64        `json:"weekly_threshold"`
+	OvertimeMultiplier float64        `json:"overtime_multiplier"`
+	WeekendMultiplier  float64        `json:"weekend_multiplier"`
+	HolidayMultiplier  float64        `json:"holiday_multiplier"`
+	IsActive           bool           `json:"is_active"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
+type OvertimeRuleListResponse struct {
+	Rules []OvertimeRuleResponse `json:"rules"`
+}
+
+type OvertimeSplitResponse struct {
+	RegularHours  float64 `json:"regular_hours"`
+	OvertimeHours float64 `json:"overtime_hours"`
+	WeekendHours  float64 `json:"weekend_hours"`
+	HolidayHours  float64 `json:"holiday_hours"`
+}
+
+type TimeEntryOvertimeResponse struct {
+	TimeEntryID uuid.UUID             `json:"time_entry_id"`
+	ProjectID   uuid.UUID             `json:"project_id"`
+	Date        string                `json:"date"`
+	Hours       float64               `json:"hours"`
+	Split       OvertimeSplitResponse `json:"split"`
+}
+
+type OvertimeReportResponse struct {
+	StartDate  string                      `json:"start_date"`
+	EndDate    string                      `json:"end_date"`
+	Rule       OvertimeRuleResponse        `json:"rule"`
+	TotalHours float64                     `json:"total_hours"`
+	Totals     OvertimeSplitResponse       `json:"totals"`
+	Entries    []TimeEntryOvertimeResponse `json:"entries"`
+}
+
+type ProjectBillingResponse struct {
+	ProjectID     uuid.UUID             `json:"project_id"`
+	Project       *ProjectSummary       `json:"project"`
+	BillableHours float64               `json:"billable_hours"`
+	Split         OvertimeSplitResponse `json:"split"`
+	BaseAmount    float64               `json:"base_amount"`
+	PremiumAmount float64               `json:"premium_amount"`
+	TotalAmount   float64               `json:"total_amount"`
+}
+
+type BillingSummaryResponse struct {
+	StartDate          string                   `json:"start_date"`
+	EndDate            string                   `json:"end_date"`
+	Projects           []ProjectBillingResponse `json:"projects"`
+	TotalBillableHours float64                  `json:"total_billable_hours"`
+	TotalBaseAmount    float64                  `json:"total_base_amount"`
+	TotalPremiumAmount float64                  `json:"total_premium_amount"`
+	TotalAmount        float64                  `json:"total_amount"`
+}
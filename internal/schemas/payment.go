@@ -0,0 +1,95 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecordPaymentRequest struct {
+	Amount      float64 `json:"amount" binding:"required,gt=0"`
+	PaymentDate *string `json:"payment_date" binding:"omitempty,datetime=2006-01-02"`
+	Method      string  `json:"method" binding:"omitempty,oneof=bank_transfer card cash cheque other"`
+	Reference   string  `json:"reference" binding:"max=200"`
+	Notes       string  `json:"notes" binding:"max=1000"`
+}
+
+type ApplyCreditRequest struct {
+	Amount      float64 `json:"amount" binding:"omitempty,gt=0"`
+	PaymentDate *string `json:"payment_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type PaymentResponse struct {
+	ID            uuid.UUID      `json:"id"`
+	InvoiceID     uuid.UUID      `json:"invoice_id"`
+	InvoiceNumber string         `json:"invoice_number,omitempty"`
+	ClientID      uuid.UUID      `json:"client_id"`
+	Client        *ClientSummary `json:"client,omitempty"`
+	PaymentDate   string         `json:"payment_date"`
+	Currency      string         `json:"currency"`
+	Amount        float64        `json:"amount"`
+	AppliedAmount float64        `json:"applied_amount"`
+	CreditAmount  float64        `json:"credit_amount"`
+	Method        string         `json:"method"`
+	Reference     string         `json:"reference"`
+	Notes         string         `json:"notes"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type PaymentListResponse struct {
+	Payments []PaymentResponse `json:"payments"`
+	Total    int64             `json:"total"`
+	Offset   int               `json:"offset"`
+	Limit    int               `json:"limit"`
+}
+
+type ClientCreditResponse struct {
+	Client   ClientSummary      `json:"client"`
+	Balances map[string]float64 `json:"balances"`
+}
+
+type AgingBucketsResponse struct {
+	Days0To30   float64 `json:"days_0_30"`
+	Days31To60  float64 `json:"days_31_60"`
+	Days61To90  float64 `json:"days_61_90"`
+	Days90Plus  float64 `json:"days_90_plus"`
+	Overdue     float64 `json:"overdue"`
+	Outstanding float64 `json:"outstanding"`
+}
+
+type ClientAgingResponse struct {
+	Client       ClientSummary        `json:"client"`
+	Currency     string               `json:"currency"`
+	InvoiceCount int                  `json:"invoice_count"`
+	Buckets      AgingBucketsResponse `json:"buckets"`
+	Credit       float64              `json:"credit"`
+}
+
+type AgingReportResponse struct {
+	AsOf    string                          `json:"as_of"`
+	Clients []ClientAgingResponse           `json:"clients"`
+	Totals  map[string]AgingBucketsResponse `json:"totals"`
+}
+
+type InvoiceMatchResponse struct {
+	InvoiceID  uuid.UUID     `json:"invoice_id"`
+	Number     string        `json:"number"`
+	Client     ClientSummary `json:"client"`
+	Currency   string        `json:"currency"`
+	BalanceDue float64       `json:"balance_due"`
+	Score      int           `json:"score"`
+	Reasons    []string      `json:"reasons"`
+}
+
+type StatementLineResponse struct {
+	Line        int                    `json:"line"`
+	Date        string                 `json:"date"`
+	Amount      float64                `json:"amount"`
+	Description string                 `json:"description"`
+	Matches     []InvoiceMatchResponse `json:"matches"`
+}
+
+type StatementMatchResponse struct {
+	Lines   []StatementLineResponse `json:"lines"`
+	Matched int                     `json:"matched"`
+}
@@ -11,10 +11,22 @@ type CreateProjectRequest struct {
 	Code         string    `json:"code" binding:"required,min=2,max=20,alphanum"`
 	Description  string    `json:"description" binding:"max=1000"`
 	ClientID     uuid.UUID `json:"client_id" binding:"required"`
-	BillableRate float64   `json:"billable_rate" binding:"required,min=0"`
+	BillableRate float64   `json:"billable_rate" binding:"min=0"`
 	Currency     string    `json:"currency" binding:"required,len=3"`
 	StartDate    string    `json:"start_date" binding:"required,datetime=2006-01-02"`
 	EndDate      *string   `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+
+	Billing *BillingTermsRequest `json:"billing"`
+}
+
+type BillingTermsRequest struct {
+	Type           string  `json:"type" binding:"required,oneof=hourly fixed_fee retainer"`
+	FixedFee       float64 `json:"fixed_fee" binding:"min=0"`
+	RetainerFee    float64 `json:"retainer_fee" binding:"min=0"`
+	RetainerHours  float64 `json:"retainer_hours" binding:"min=0"`
+	OverageRate    float64 `json:"overage_rate" binding:"min=0"`
+	RolloverPolicy string  `json:"rollover_policy" binding:"omitempty,oneof=none carry_forward"`
+	RolloverCap    float64 `json:"rollover_cap" binding:"min=0"`
 }
 
 type UpdateProjectRequest struct {
@@ -27,23 +39,38 @@ type UpdateProjectRequest struct {
 	StartDate    *string  `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
 	EndDate      *string  `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
 	IsActive     *bool    `json:"is_active"`
+
+	RateEffectiveFrom *string              `json:"rate_effective_from" binding:"omitempty,datetime=2006-01-02"`
+	Billing           *BillingTermsRequest `json:"billing"`
 }
 
 type ProjectResponse struct {
-	ID           uuid.UUID       `json:"id"`
-	Name         string          `json:"name"`
-	Code         string          `json:"code"`
-	Description  string          `json:"description"`
-	Status       string          `json:"status"`
-	BillableRate float64         `json:"billable_rate"`
-	Currency     string          `json:"currency"`
-	StartDate    string          `json:"start_date"`
-	EndDate      *string         `json:"end_date,omitempty"`
-	IsActive     bool            `json:"is_active"`
-	ClientID     uuid.UUID       `json:"client_id"`
-	Client       *ClientResponse `json:"client,omitempty"`
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
+	ID           uuid.UUID            `json:"id"`
+	Name         string               `json:"name"`
+	Code         string               `json:"code"`
+	Description  string               `json:"description"`
+	Status       string               `json:"status"`
+	BillableRate float64              `json:"billable_rate"`
+	Currency     string               `json:"currency"`
+	Billing      BillingTermsResponse `json:"billing"`
+	StartDate    string               `json:"start_date"`
+	EndDate      *string              `json:"end_date,omitempty"`
+	IsActive     bool                 `json:"is_active"`
+	ClientID     uuid.UUID            `json:"client_id"`
+	Client       *ClientResponse      `json:"client,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+	DeletedAt    *time.Time           `json:"deleted_at,omitempty"`
+}
+
+type BillingTermsResponse struct {
+	Type           string  `json:"type"`
+	FixedFee       float64 `json:"fixed_fee,omitempty"`
+	RetainerFee    float64 `json:"retainer_fee,omitempty"`
+	RetainerHours  float64 `json:"retainer_hours,omitempty"`
+	OverageRate    float64 `json:"overage_rate,omitempty"`
+	RolloverPolicy string  `json:"rollover_policy,omitempty"`
+	RolloverCap    float64 `json:"rollover_cap,omitempty"`
 }
 
 type ProjectListResponse struct {
@@ -0,0 +1,48 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SetProjectRateRequest struct {
+	Rate          *float64 `json:"rate" binding:"required,min=0"`
+	EffectiveFrom string   `json:"effective_from" binding:"required,datetime=2006-01-02"`
+}
+
+type ProjectRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Rate          float64   `json:"rate"`
+	EffectiveFrom string    `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ProjectRateListResponse struct {
+	ProjectID   uuid.UUID             `json:"project_id"`
+	Currency    string                `json:"currency"`
+	CurrentRate float64               `json:"current_rate"`
+	Rates       []ProjectRateResponse `json:"rates"`
+}
+
+type SetMemberRateRequest struct {
+	UserID        uuid.UUID `json:"user_id" binding:"required"`
+	Rate          *float64  `json:"rate" binding:"required,min=0"`
+	EffectiveFrom string    `json:"effective_from" binding:"required,datetime=2006-01-02"`
+}
+
+type MemberRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	UserID        uuid.UUID `json:"user_id"`
+	Username      string    `json:"username"`
+	Rate          float64   `json:"rate"`
+	EffectiveFrom string    `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type MemberRateListResponse struct {
+	ProjectID uuid.UUID            `json:"project_id"`
+	Rates     []MemberRateResponse `json:"rates"`
+}
@@ -0,0 +1,270 @@
+package schemas
+
+import "github.com/google/uuid"
+
+type MissingTimesheetDay struct {
+	Date          string  `json:"date"`
+	Status        string  `json:"status"`
+	ExpectedHours float64 `json:"expected_hours"`
+	LoggedHours   float64 `json:"logged_hours"`
+	MissingHours  float64 `json:"missing_hours"`
+}
+
+type MissingTimesheetWeek struct {
+	WeekStarting  string                `json:"week_starting"`
+	ExpectedHours float64               `json:"expected_hours"`
+	LoggedHours   float64               `json:"logged_hours"`
+	Days          []MissingTimesheetDay `json:"days"`
+}
+
+type MissingTimesheetUser struct {
+	UserID        uuid.UUID              `json:"user_id"`
+	Username      string                 `json:"username"`
+	FullName      string                 `json:"full_name"`
+	ExpectedHours float64                `json:"expected_hours"`
+	LoggedHours   float64                `json:"logged_hours"`
+	MissingHours  float64                `json:"missing_hours"`
+	MissingDays   int                    `json:"missing_days"`
+	Weeks         []MissingTimesheetWeek `json:"weeks"`
+}
+
+type MissingTimesheetReportResponse struct {
+	StartDate string                 `json:"start_date"`
+	EndDate   string                 `json:"end_date"`
+	Users     []MissingTimesheetUser `json:"users"`
+}
+
+type ExpenseTotalResponse struct {
+	Currency                string             `json:"currency"`
+	Amount                  float64            `json:"amount"`
+	BillableAmount          float64            `json:"billable_amount"`
+	ConvertedAmount         *float64           `json:"converted_amount,omitempty"`
+	ConvertedBillableAmount *float64           `json:"converted_billable_amount,omitempty"`
+	ByCategory              map[string]float64 `json:"by_category"`
+}
+
+type ConvertedTotalsResponse struct {
+	Currency              string   `json:"currency"`
+	BillableAmount        float64  `json:"billable_amount"`
+	ExpenseAmount         float64  `json:"expense_amount"`
+	BillableExpenseAmount float64  `json:"billable_expense_amount"`
+	TravelAmount          float64  `json:"travel_amount"`
+	BillableTravelAmount  float64  `json:"billable_travel_amount"`
+	BillableTotal         float64  `json:"billable_total"`
+	MissingRates          []string `json:"missing_rates"`
+}
+
+type TravelTotalResponse struct {
+	Count          int     `json:"count"`
+	Quantity       float64 `json:"quantity"`
+	Amount         float64 `json:"amount"`
+	BillableAmount float64 `json:"billable_amount"`
+}
+
+type ProjectReportResponse struct {
+	Project          ProjectSummary           `json:"project"`
+	StartDate        *string                  `json:"start_date,omitempty"`
+	EndDate          *string                  `json:"end_date,omitempty"`
+	TotalHours       float64                  `json:"total_hours"`
+	BillableHours    float64                  `json:"billable_hours"`
+	WrittenDownHours float64                  `json:"written_down_hours"`
+	BillableAmount   float64                  `json:"billable_amount"`
+	Expenses         []ExpenseTotalResponse   `json:"expenses"`
+	Mileage          TravelTotalResponse      `json:"mileage"`
+	PerDiem          TravelTotalResponse      `json:"per_diem"`
+	Converted        *ConvertedTotalsResponse `json:"converted,omitempty"`
+}
+
+type ClientReportResponse struct {
+	Client           ClientSummary            `json:"client"`
+	StartDate        *string                  `json:"start_date,omitempty"`
+	EndDate          *string                  `json:"end_date,omitempty"`
+	TotalHours       float64                  `json:"total_hours"`
+	BillableHours    float64                  `json:"billable_hours"`
+	WrittenDownHours float64                  `json:"written_down_hours"`
+	BillableAmounts  map[string]float64       `json:"billable_amounts"`
+	Expenses         []ExpenseTotalResponse   `json:"expenses"`
+	TravelAmounts    map[string]float64       `json:"travel_amounts"`
+	Converted        *ConvertedTotalsResponse `json:"converted,omitempty"`
+	Projects         []ProjectReportResponse  `json:"projects"`
+}
+
+type FixedFeeConsumptionResponse struct {
+	Fee             float64             `json:"fee"`
+	ConsumedPercent float64             `json:"consumed_percent"`
+	RemainingValue  float64             `json:"remaining_value"`
+	EffectiveRate   float64             `json:"effective_rate"`
+	CompletedAmount float64             `json:"completed_amount"`
+	Milestones      []MilestoneResponse `json:"milestones"`
+}
+
+type RetainerPeriodResponse struct {
+	Month          string  `json:"month"`
+	IncludedHours  float64 `json:"included_hours"`
+	RolledOver     float64 `json:"rolled_over"`
+	AvailableHours float64 `json:"available_hours"`
+	UsedHours      float64 `json:"used_hours"`
+	OverageHours   float64 `json:"overage_hours"`
+	OverageAmount  float64 `json:"overage_amount"`
+	CarriedForward float64 `json:"carried_forward"`
+}
+
+type RetainerConsumptionResponse struct {
+	MonthlyFee   float64                  `json:"monthly_fee"`
+	TotalFees    float64                  `json:"total_fees"`
+	TotalOverage float64                  `json:"total_overage"`
+	Periods      []RetainerPeriodResponse `json:"periods"`
+}
+
+type ProjectConsumptionResponse struct {
+	Project       ProjectSummary               `json:"project"`
+	BillingType   string                       `json:"billing_type"`
+	AsOf          string                       `json:"as_of"`
+	LoggedHours   float64                      `json:"logged_hours"`
+	BillableHours float64                      `json:"billable_hours"`
+	HourlyValue   float64                      `json:"hourly_value"`
+	FixedFee      *FixedFeeConsumptionResponse `json:"fixed_fee,omitempty"`
+	Retainer      *RetainerConsumptionResponse `json:"retainer,omitempty"`
+}
+
+type UtilizationTotalsResponse struct {
+	CapacityHours       float64 `json:"capacity_hours"`
+	HolidayHours        float64 `json:"holiday_hours"`
+	LeaveHours          float64 `json:"leave_hours"`
+	AvailableHours      float64 `json:"available_hours"`
+	TotalHours          float64 `json:"total_hours"`
+	BillableHours       float64 `json:"billable_hours"`
+	NonBillableHours    float64 `json:"non_billable_hours"`
+	Utilization         float64 `json:"utilization"`
+	BillableUtilization float64 `json:"billable_utilization"`
+	Target              float64 `json:"target"`
+	TargetHours         float64 `json:"target_hours"`
+	TargetVariance      float64 `json:"target_variance"`
+}
+
+type UtilizationPeriodResponse struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	UtilizationTotalsResponse
+}
+
+type ConsultantUtilizationResponse struct {
+	Consultant ConsultantSummary           `json:"consultant"`
+	Periods    []UtilizationPeriodResponse `json:"periods"`
+	UtilizationTotalsResponse
+}
+
+type UtilizationReportResponse struct {
+	StartDate   string                          `json:"start_date"`
+	EndDate     string                          `json:"end_date"`
+	Period      string                          `json:"period"`
+	Consultants []ConsultantUtilizationResponse `json:"consultants"`
+	Totals      UtilizationTotalsResponse       `json:"totals"`
+}
+
+type RevenueComparisonResponse struct {
+	Hours          float64  `json:"hours"`
+	Amount         float64  `json:"amount"`
+	PreviousHours  float64  `json:"previous_hours"`
+	PreviousAmount float64  `json:"previous_amount"`
+	Change         float64  `json:"change"`
+	ChangePercent  *float64 `json:"change_percent"`
+}
+
+type RevenuePeriodResponse struct {
+	StartDate       string   `json:"start_date"`
+	EndDate         string   `json:"end_date"`
+	Hours           float64  `json:"hours"`
+	Amount          float64  `json:"amount"`
+	ConvertedAmount *float64 `json:"converted_amount,omitempty"`
+}
+
+type RevenueRowResponse struct {
+	Client     *ClientSummary             `json:"client,omitempty"`
+	Project    *ProjectSummary            `json:"project,omitempty"`
+	Consultant *ConsultantSummary         `json:"consultant,omitempty"`
+	Currency   string                     `json:"currency"`
+	Converted  *RevenueComparisonResponse `json:"converted,omitempty"`
+	Periods    []RevenuePeriodResponse    `json:"periods"`
+	RevenueComparisonResponse
+}
+
+type RevenueTotalResponse struct {
+	Currency string `json:"currency"`
+	RevenueComparisonResponse
+}
+
+type RevenuePeriodTotalResponse struct {
+	StartDate       string             `json:"start_date"`
+	EndDate         string             `json:"end_date"`
+	Hours           float64            `json:"hours"`
+	Amounts         map[string]float64 `json:"amounts"`
+	ConvertedAmount *float64           `json:"converted_amount,omitempty"`
+}
+
+type ConvertedRevenueResponse struct {
+	Currency     string   `json:"currency"`
+	MissingRates []string `json:"missing_rates"`
+	RevenueComparisonResponse
+}
+
+type RevenueReportResponse struct {
+	StartDate         string                       `json:"start_date"`
+	EndDate           string                       `json:"end_date"`
+	PreviousStartDate string                       `json:"previous_start_date"`
+	PreviousEndDate   string                       `json:"previous_end_date"`
+	GroupBy           string                       `json:"group_by"`
+	Period            string                       `json:"period"`
+	Rows              []RevenueRowResponse         `json:"rows"`
+	Periods           []RevenuePeriodTotalResponse `json:"periods"`
+	Totals            []RevenueTotalResponse       `json:"totals"`
+	Converted         *ConvertedRevenueResponse    `json:"converted,omitempty"`
+}
+
+type ForecastAmountsResponse struct {
+	AllocatedHours  float64  `json:"allocated_hours"`
+	ForecastAmount  float64  `json:"forecast_amount"`
+	ActualHours     float64  `json:"actual_hours"`
+	ActualAmount    float64  `json:"actual_amount"`
+	ProjectedHours  float64  `json:"projected_hours"`
+	ProjectedAmount float64  `json:"projected_amount"`
+	VarianceHours   *float64 `json:"variance_hours,omitempty"`
+	VarianceAmount  *float64 `json:"variance_amount,omitempty"`
+}
+
+type ForecastLineResponse struct {
+	Project  ProjectSummary `json:"project"`
+	Currency string         `json:"currency"`
+	ForecastAmountsResponse
+}
+
+type ForecastTotalResponse struct {
+	Currency string `json:"currency"`
+	ForecastAmountsResponse
+}
+
+type ForecastWeekResponse struct {
+	WeekStarting string                   `json:"week_starting"`
+	Status       string                   `json:"status"`
+	Projects     []ForecastLineResponse   `json:"projects"`
+	Totals       []ForecastTotalResponse  `json:"totals"`
+	Converted    *ForecastAmountsResponse `json:"converted,omitempty"`
+}
+
+type ConvertedForecastResponse struct {
+	Currency     string                  `json:"currency"`
+	Upcoming     ForecastAmountsResponse `json:"upcoming"`
+	Past         ForecastAmountsResponse `json:"past"`
+	MissingRates []string                `json:"missing_rates"`
+}
+
+type RevenueForecastResponse struct {
+	AsOf      string                     `json:"as_of"`
+	TimeZone  string                     `json:"time_zone"`
+	StartDate string                     `json:"start_date"`
+	EndDate   string                     `json:"end_date"`
+	Weeks     []ForecastWeekResponse     `json:"weeks"`
+	Upcoming  []ForecastTotalResponse    `json:"upcoming"`
+	Past      []ForecastTotalResponse    `json:"past"`
+	Converted *ConvertedForecastResponse `json:"converted,omitempty"`
+}
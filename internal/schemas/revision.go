@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevisionResponse struct {
+	ID            uuid.UUID              `json:"id"`
+	Action        string                 `json:"action"`
+	UserID        uuid.UUID              `json:"user_id"`
+	Username      string                 `json:"username"`
+	Before        map[string]interface{} `json:"before"`
+	After         map[string]interface{} `json:"after"`
+	ChangedFields []string               `json:"changed_fields"`
+	Override      bool                   `json:"override"`
+	Reason        string                 `json:"reason,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+type RevisionHistoryResponse struct {
+	EntityType string             `json:"entity_type"`
+	EntityID   uuid.UUID          `json:"entity_id"`
+	Revisions  []RevisionResponse `json:"revisions"`
+}
+
+type OverrideAuditResponse struct {
+	EntityType string    `json:"entity_type"`
+	EntityID   uuid.UUID `json:"entity_id"`
+	RevisionResponse
+}
+
+type OverrideAuditListResponse struct {
+	Overrides []OverrideAuditResponse `json:"overrides"`
+	Total     int64                   `json:"total"`
+	Offset    int                     `json:"offset"`
+	Limit     int                     `json:"limit"`
+}
@@ -0,0 +1,44 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTaxRateRequest struct {
+	Country       string  `json:"country" binding:"required,len=2,alpha"`
+	Name          string  `json:"name" binding:"required,min=1,max=50"`
+	Rate          float64 `json:"rate" binding:"min=0,max=100"`
+	EffectiveFrom string  `json:"effective_from" binding:"required,datetime=2006-01-02"`
+}
+
+type TaxRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Country       string    `json:"country"`
+	Name          string    `json:"name"`
+	Rate          float64   `json:"rate"`
+	EffectiveFrom string    `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TaxRateListResponse struct {
+	Rates []TaxRateResponse `json:"rates"`
+}
+
+type TaxJurisdictionResponse struct {
+	Country       string  `json:"country"`
+	Treatment     string  `json:"treatment"`
+	Currency      string  `json:"currency"`
+	Rate          float64 `json:"rate"`
+	InvoiceCount  int     `json:"invoice_count"`
+	TaxableAmount float64 `json:"taxable_amount"`
+	TaxAmount     float64 `json:"tax_amount"`
+}
+
+type TaxReportResponse struct {
+	StartDate     string                    `json:"start_date"`
+	EndDate       string                    `json:"end_date"`
+	Jurisdictions []TaxJurisdictionResponse `json:"jurisdictions"`
+	TaxAmounts    map[string]float64        `json:"tax_amounts"`
+}
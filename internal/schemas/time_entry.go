@@ -11,25 +11,31 @@ type CreateTimeEntryRequest struct {
 	Date        string    `json:"date" binding:"required,datetime=2006-01-02"`
 	Hours       float64   `json:"hours" binding:"required,min=0,max=24"`
 	Description string    `json:"description" binding:"required,min=1,max=1000"`
-	IsBillable  bool      `json:"is_billable"`
+	IsBillable  *bool     `json:"is_billable"`
 }
 
 type UpdateTimeEntryRequest struct {
 	Hours       float64 `json:"hours" binding:"required,min=0,max=24"`
 	Description string  `json:"description" binding:"required,min=1,max=1000"`
-	IsBillable  bool    `json:"is_billable"`
+	IsBillable  *bool   `json:"is_billable"`
 }
 
 type TimeEntryResponse struct {
-	ID          uuid.UUID       `json:"id"`
-	ProjectID   uuid.UUID       `json:"project_id"`
-	Project     *ProjectSummary `json:"project,omitempty"`
-	Date        string          `json:"date"`
-	Hours       float64         `json:"hours"`
-	Description string          `json:"description"`
-	IsBillable  bool            `json:"is_billable"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
+	ID               uuid.UUID       `json:"id"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Project          *ProjectSummary `json:"project,omitempty"`
+	Date             string          `json:"date"`
+	Hours            float64         `json:"hours"`
+	Description      string          `json:"description"`
+	IsBillable       bool            `json:"is_billable"`
+	WrittenDownHours float64         `json:"written_down_hours"`
+	BillableHours    float64         `json:"billable_hours"`
+	CreditedHours    float64         `json:"credited_hours"`
+	InvoiceID        *uuid.UUID      `json:"invoice_id,omitempty"`
+	IsLocked         bool            `json:"is_locked"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	DeletedAt        *time.Time      `json:"deleted_at,omitempty"`
 }
 
 type TimeEntryListResponse struct {
@@ -52,6 +58,19 @@ type WeekEntriesResponse struct {
 	WeekTotal    float64             `json:"week_total"`
 }
 
+type MonthEntriesResponse struct {
+	Month            string              `json:"month"`
+	StartDate        string              `json:"start_date"`
+	EndDate          string              `json:"end_date"`
+	TimeZone         string              `json:"time_zone"`
+	TimeEntries      []TimeEntryResponse `json:"time_entries"`
+	DailyTotals      map[string]float64  `json:"daily_totals"`
+	WeeklyTotals     map[string]float64  `json:"weekly_totals"`
+	MonthTotal       float64             `json:"month_total"`
+	BillableHours    float64             `json:"billable_hours"`
+	NonBillableHours float64             `json:"non_billable_hours"`
+}
+
 type ProjectWeekComparisonResponse struct {
 	ProjectID       uuid.UUID `json:"project_id"`
 	WeekStarting    string    `json:"week_starting"`
@@ -62,16 +81,46 @@ type ProjectWeekComparisonResponse struct {
 }
 
 type WeekSummaryResponse struct {
-	WeekStarting   string               `json:"week_starting"`
-	Projects       []ProjectWeekSummary `json:"projects"`
-	TotalAllocated float64              `json:"total_allocated"`
-	TotalActual    float64              `json:"total_actual"`
+	WeekStarting        string                `json:"week_starting"`
+	Projects            []ProjectWeekSummary  `json:"projects"`
+	TotalAllocated      float64               `json:"total_allocated"`
+	TotalActual         float64               `json:"total_actual"`
+	Overtime            OvertimeSplitResponse `json:"overtime"`
+	TotalBillableAmount float64               `json:"total_billable_amount"`
 }
 
 type ProjectWeekSummary struct {
-	ProjectID      uuid.UUID       `json:"project_id"`
-	Project        *ProjectSummary `json:"project"`
-	AllocatedHours float64         `json:"allocated_hours"`
-	ActualHours    float64         `json:"actual_hours"`
-	Variance       float64         `json:"variance"`
+	ProjectID      uuid.UUID             `json:"project_id"`
+	Project        *ProjectSummary       `json:"project"`
+	AllocatedHours float64               `json:"allocated_hours"`
+	ActualHours    float64               `json:"actual_hours"`
+	Variance       float64               `json:"variance"`
+	Overtime       OvertimeSplitResponse `json:"overtime"`
+	BillableAmount float64               `json:"billable_amount"`
+}
+
+type MonthSummaryResponse struct {
+	Month               string                `json:"month"`
+	StartDate           string                `json:"start_date"`
+	EndDate             string                `json:"end_date"`
+	TimeZone            string                `json:"time_zone"`
+	Projects            []ProjectMonthSummary `json:"projects"`
+	TotalAllocated      float64               `json:"total_allocated"`
+	TotalActual         float64               `json:"total_actual"`
+	TotalBillable       float64               `json:"total_billable"`
+	TotalNonBillable    float64               `json:"total_non_billable"`
+	Overtime            OvertimeSplitResponse `json:"overtime"`
+	TotalBillableAmount float64               `json:"total_billable_amount"`
+}
+
+type ProjectMonthSummary struct {
+	ProjectID        uuid.UUID             `json:"project_id"`
+	Project          *ProjectSummary       `json:"project"`
+	AllocatedHours   float64               `json:"allocated_hours"`
+	ActualHours      float64               `json:"actual_hours"`
+	Variance         float64               `json:"variance"`
+	BillableHours    float64               `json:"billable_hours"`
+	NonBillableHours float64               `json:"non_billable_hours"`
+	Overtime         OvertimeSplitResponse `json:"overtime"`
+	BillableAmount   float64               `json:"billable_amount"`
 }
@@ -0,0 +1,146 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateMileageRateRequest struct {
+	VehicleType   string  `json:"vehicle_type" binding:"required,oneof=car electric_car motorcycle bicycle"`
+	Currency      string  `json:"currency" binding:"required,len=3"`
+	RatePerUnit   float64 `json:"rate_per_unit" binding:"required,gt=0"`
+	DistanceUnit  string  `json:"distance_unit" binding:"omitempty,oneof=km mi"`
+	EffectiveFrom string  `json:"effective_from" binding:"required,datetime=2006-01-02"`
+}
+
+type MileageRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	VehicleType   string    `json:"vehicle_type"`
+	Currency      string    `json:"currency"`
+	RatePerUnit   float64   `json:"rate_per_unit"`
+	DistanceUnit  string    `json:"distance_unit"`
+	EffectiveFrom string    `json:"effective_from"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type MileageRateListResponse struct {
+	Rates []MileageRateResponse `json:"rates"`
+}
+
+type CreatePerDiemRateRequest struct {
+	Country       string  `json:"country" binding:"required,len=2,alpha"`
+	Currency      string  `json:"currency" binding:"required,len=3"`
+	DailyRate     float64 `json:"daily_rate" binding:"required,gt=0"`
+	EffectiveFrom string  `json:"effective_from" binding:"required,datetime=2006-01-02"`
+}
+
+type PerDiemRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Country       string    `json:"country"`
+	Currency      string    `json:"currency"`
+	DailyRate     float64   `json:"daily_rate"`
+	EffectiveFrom string    `json:"effective_from"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type PerDiemRateListResponse struct {
+	Rates []PerDiemRateResponse `json:"rates"`
+}
+
+type CreateMileageEntryRequest struct {
+	ProjectID    uuid.UUID `json:"project_id" binding:"required"`
+	Date         string    `json:"date" binding:"required,datetime=2006-01-02"`
+	FromLocation string    `json:"from_location" binding:"required,min=1,max=200"`
+	ToLocation   string    `json:"to_location" binding:"required,min=1,max=200"`
+	Distance     float64   `json:"distance" binding:"required,gt=0"`
+	VehicleType  string    `json:"vehicle_type" binding:"required,oneof=car electric_car motorcycle bicycle"`
+	Description  string    `json:"description" binding:"max=1000"`
+	IsBillable   *bool     `json:"is_billable"`
+}
+
+type UpdateMileageEntryRequest struct {
+	Date         *string  `json:"date" binding:"omitempty,datetime=2006-01-02"`
+	FromLocation *string  `json:"from_location" binding:"omitempty,min=1,max=200"`
+	ToLocation   *string  `json:"to_location" binding:"omitempty,min=1,max=200"`
+	Distance     *float64 `json:"distance" binding:"omitempty,gt=0"`
+	VehicleType  *string  `json:"vehicle_type" binding:"omitempty,oneof=car electric_car motorcycle bicycle"`
+	Description  *string  `json:"description" binding:"omitempty,max=1000"`
+	IsBillable   *bool    `json:"is_billable"`
+}
+
+type MileageEntryResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	ProjectID    uuid.UUID       `json:"project_id"`
+	Project      *ProjectSummary `json:"project,omitempty"`
+	Date         string          `json:"date"`
+	FromLocation string          `json:"from_location"`
+	ToLocation   string          `json:"to_location"`
+	Distance     float64         `json:"distance"`
+	DistanceUnit string          `json:"distance_unit"`
+	VehicleType  string          `json:"vehicle_type"`
+	Description  string          `json:"description"`
+	IsBillable   bool            `json:"is_billable"`
+	RateID       uuid.UUID       `json:"rate_id"`
+	RateVersion  int             `json:"rate_version"`
+	RatePerUnit  float64         `json:"rate_per_unit"`
+	Currency     string          `json:"currency"`
+	Amount       float64         `json:"amount"`
+	InvoiceID    *uuid.UUID      `json:"invoice_id,omitempty"`
+	IsLocked     bool            `json:"is_locked"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+type MileageEntryListResponse struct {
+	MileageEntries []MileageEntryResponse `json:"mileage_entries"`
+	Total          int64                  `json:"total"`
+	Offset         int                    `json:"offset"`
+	Limit          int                    `json:"limit"`
+}
+
+type CreatePerDiemClaimRequest struct {
+	ProjectID   uuid.UUID `json:"project_id" binding:"required"`
+	Date        string    `json:"date" binding:"required,datetime=2006-01-02"`
+	Days        float64   `json:"days" binding:"required,gt=0,max=31"`
+	Country     string    `json:"country" binding:"required,len=2,alpha"`
+	Description string    `json:"description" binding:"max=1000"`
+	IsBillable  *bool     `json:"is_billable"`
+}
+
+type UpdatePerDiemClaimRequest struct {
+	Date        *string  `json:"date" binding:"omitempty,datetime=2006-01-02"`
+	Days        *float64 `json:"days" binding:"omitempty,gt=0,max=31"`
+	Country     *string  `json:"country" binding:"omitempty,len=2,alpha"`
+	Description *string  `json:"description" binding:"omitempty,max=1000"`
+	IsBillable  *bool    `json:"is_billable"`
+}
+
+type PerDiemClaimResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	ProjectID   uuid.UUID       `json:"project_id"`
+	Project     *ProjectSummary `json:"project,omitempty"`
+	Date        string          `json:"date"`
+	Days        float64         `json:"days"`
+	Country     string          `json:"country"`
+	Description string          `json:"description"`
+	IsBillable  bool            `json:"is_billable"`
+	RateID      uuid.UUID       `json:"rate_id"`
+	RateVersion int             `json:"rate_version"`
+	DailyRate   float64         `json:"daily_rate"`
+	Currency    string          `json:"currency"`
+	Amount      float64         `json:"amount"`
+	InvoiceID   *uuid.UUID      `json:"invoice_id,omitempty"`
+	IsLocked    bool            `json:"is_locked"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type PerDiemClaimListResponse struct {
+	PerDiemClaims []PerDiemClaimResponse `json:"per_diem_claims"`
+	Total         int64                  `json:"total"`
+	Offset        int                    `json:"offset"`
+	Limit         int                    `json:"limit"`
+}
@@ -0,0 +1,67 @@
+package schemas
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateWriteDownRequest struct {
+	Hours  float64 `json:"hours" binding:"required,gt=0"`
+	Reason string  `json:"reason" binding:"required,oneof=goodwill inefficiency scope_dispute rate_dispute training other"`
+	Notes  string  `json:"notes" binding:"max=1000"`
+}
+
+type WriteDownResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	TimeEntryID   *uuid.UUID `json:"time_entry_id,omitempty"`
+	InvoiceID     *uuid.UUID `json:"invoice_id,omitempty"`
+	InvoiceLineID *uuid.UUID `json:"invoice_line_id,omitempty"`
+	Hours         float64    `json:"hours"`
+	Reason        string     `json:"reason"`
+	Notes         string     `json:"notes"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type WriteDownListResponse struct {
+	WriteDowns []WriteDownResponse `json:"write_downs"`
+	TotalHours float64             `json:"total_hours"`
+}
+
+type ConsultantSummary struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+}
+
+type RealizationTotalsResponse struct {
+	LoggedHours      float64 `json:"logged_hours"`
+	LoggedValue      float64 `json:"logged_value"`
+	WrittenDownHours float64 `json:"written_down_hours"`
+	WrittenDownValue float64 `json:"written_down_value"`
+	BilledHours      float64 `json:"billed_hours"`
+	BilledValue      float64 `json:"billed_value"`
+	UnbilledHours    float64 `json:"unbilled_hours"`
+	UnbilledValue    float64 `json:"unbilled_value"`
+	RealizationRate  float64 `json:"realization_rate"`
+}
+
+type ProjectRealizationResponse struct {
+	Project  ProjectSummary `json:"project"`
+	Currency string         `json:"currency"`
+	RealizationTotalsResponse
+}
+
+type ConsultantRealizationResponse struct {
+	Consultant ConsultantSummary `json:"consultant"`
+	Currency   string            `json:"currency"`
+	RealizationTotalsResponse
+}
+
+type RealizationReportResponse struct {
+	StartDate        string                          `json:"start_date"`
+	EndDate          string                          `json:"end_date"`
+	Projects         []ProjectRealizationResponse    `json:"projects"`
+	Consultants      []ConsultantRealizationResponse `json:"consultants"`
+	WriteDownReasons map[string]float64              `json:"write_down_reasons"`
+}
@@ -0,0 +1,613 @@
+package services
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type AccountingService struct{}
+
+func NewAccountingService() *AccountingService {
+	return &AccountingService{}
+}
+
+var (
+	ErrAccountMappingNotFound = errors.New("account mapping not found")
+	ErrAccountMappingExists   = errors.New("an account mapping already exists for this scope")
+	ErrAccountMappingScope    = errors.New("an account mapping applies to a client or a project, not both")
+	ErrInvalidExportFormat    = errors.New("format must be iif, xero, xero_payments or journal")
+)
+
+type AccountingExportFormat string
+
+const (
+	AccountingExportIIF          AccountingExportFormat = "iif"
+	AccountingExportXero         AccountingExportFormat = "xero"
+	AccountingExportXeroPayments AccountingExportFormat = "xero_payments"
+	AccountingExportJournal      AccountingExportFormat = "journal"
+)
+
+func (s *AccountingService) CreateMapping(userID uuid.UUID, mapping models.AccountMapping) (*models.AccountMapping, error) {
+	if mapping.ClientID != nil && mapping.ProjectID != nil {
+		return nil, ErrAccountMappingScope
+	}
+	if mapping.ClientID != nil {
+		var client models.Client
+		if err := database.DB.Where("id = ? AND user_id = ?", *mapping.ClientID, userID).First(&client).Error; err != nil {
+			return nil, ErrClientNotFound
+		}
+	}
+	if mapping.ProjectID != nil {
+		var project models.Project
+		if err := database.DB.Where("id = ? AND user_id = ?", *mapping.ProjectID, userID).First(&project).Error; err != nil {
+			return nil, ErrProjectNotFound
+		}
+	}
+
+	query := database.DB.Where("user_id = ?", userID)
+	if mapping.ClientID != nil {
+		query = query.Where("client_id = ?", *mapping.ClientID)
+	} else {
+		query = query.Where("client_id IS NULL")
+	}
+	if mapping.ProjectID != nil {
+		query = query.Where("project_id = ?", *mapping.ProjectID)
+	} else {
+		query = query.Where("project_id IS NULL")
+	}
+	var existing models.AccountMapping
+	if err := query.First(&existing).Error; err == nil {
+		return nil, ErrAccountMappingExists
+	}
+
+	mapping.ID = uuid.Nil
+	mapping.UserID = userID
+	if err := database.DB.Create(&mapping).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetMapping(userID, mapping.ID)
+}
+
+func (s *AccountingService) GetMapping(userID, mappingID uuid.UUID) (*models.AccountMapping, error) {
+	var mapping models.AccountMapping
+	err := database.DB.Preload("Client").Preload("Project.Client").
+		Where("id = ? AND user_id = ?", mappingID, userID).
+		First(&mapping).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountMappingNotFound
+		}
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func (s *AccountingService) ListMappings(userID uuid.UUID) ([]*models.AccountMapping, error) {
+	var mappings []*models.AccountMapping
+	err := database.DB.Preload("Client").Preload("Project.Client").
+		Where("user_id = ?", userID).
+		Order("client_id IS NOT NULL, project_id IS NOT NULL, created_at ASC").
+		Find(&mappings).Error
+	return mappings, err
+}
+
+func (s *AccountingService) UpdateMapping(userID, mappingID uuid.UUID, updates map[string]interface{}) (*models.AccountMapping, error) {
+	mapping, err := s.GetMapping(userID, mappingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Model(mapping).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetMapping(userID, mappingID)
+}
+
+func (s *AccountingService) DeleteMapping(userID, mappingID uuid.UUID) error {
+	result := database.DB.Where("id = ? AND user_id = ?", mappingID, userID).Delete(&models.AccountMapping{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAccountMappingNotFound
+	}
+	return nil
+}
+
+func (s *AccountingService) DefaultAccounts(userID uuid.UUID) (models.AccountMapping, error) {
+	resolver, err := loadAccountResolver(userID)
+	if err != nil {
+		return models.AccountMapping{}, err
+	}
+	return resolver.defaults, nil
+}
+
+type accountResolver struct {
+	defaults  models.AccountMapping
+	byClient  map[uuid.UUID]models.AccountMapping
+	byProject map[uuid.UUID]models.AccountMapping
+}
+
+func loadAccountResolver(userID uuid.UUID) (*accountResolver, error) {
+	var mappings []models.AccountMapping
+	if err := database.DB.Where("user_id = ?", userID).Find(&mappings).Error; err != nil {
+		return nil, err
+	}
+
+	resolver := &accountResolver{
+		defaults:  models.DefaultAccountMapping(),
+		byClient:  make(map[uuid.UUID]models.AccountMapping),
+		byProject: make(map[uuid.UUID]models.AccountMapping),
+	}
+	for _, mapping := range mappings {
+		switch {
+		case mapping.ProjectID != nil:
+			resolver.byProject[*mapping.ProjectID] = mapping
+		case mapping.ClientID != nil:
+			resolver.byClient[*mapping.ClientID] = mapping
+		default:
+			resolver.defaults = mergeAccountMapping(mapping, resolver.defaults)
+		}
+	}
+	return resolver, nil
+}
+
+func mergeAccountMapping(mapping, fallback models.AccountMapping) models.AccountMapping {
+	if mapping.ReceivableAccount == "" {
+		mapping.ReceivableAccount = fallback.ReceivableAccount
+	}
+	if mapping.RevenueAccount == "" {
+		mapping.RevenueAccount = fallback.RevenueAccount
+	}
+	if mapping.TaxAccount == "" {
+		mapping.TaxAccount = fallback.TaxAccount
+	}
+	if mapping.BankAccount == "" {
+		mapping.BankAccount = fallback.BankAccount
+	}
+	return mapping
+}
+
+func (r *accountResolver) resolve(clientID uuid.UUID, projectID *uuid.UUID) models.AccountMapping {
+	mapping := mergeAccountMapping(r.byClient[clientID], r.defaults)
+	if projectID != nil {
+		mapping = mergeAccountMapping(r.byProject[*projectID], mapping)
+	}
+	return mapping
+}
+
+type accountingDocuments struct {
+	invoices    []*models.Invoice
+	creditNotes []*models.CreditNote
+	payments    []*models.Payment
+}
+
+func loadAccountingDocuments(userID uuid.UUID, startDate, endDate time.Time) (*accountingDocuments, error) {
+	docs := &accountingDocuments{}
+	start, end := datatypes.Date(startDate), datatypes.Date(endDate)
+
+	err := database.DB.Preload("Client").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Lines.Project").
+		Preload("TaxLines").
+		Where("user_id = ? AND status IN ?", userID, []models.InvoiceStatus{models.InvoiceStatusIssued, models.InvoiceStatusPaid, models.InvoiceStatusCredited}).
+		Where("issue_date >= ? AND issue_date <= ?", start, end).
+		Order("issue_date ASC, number ASC").
+		Find(&docs.invoices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.DB.Preload("Client").
+		Preload("Invoice.TaxLines").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Lines.Project").
+		Where("user_id = ? AND issue_date >= ? AND issue_date <= ?", userID, start, end).
+		Order("issue_date ASC, number ASC").
+		Find(&docs.creditNotes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.DB.Preload("Client").Preload("Invoice").
+		Where("user_id = ? AND method != ?", userID, models.PaymentMethodCredit).
+		Where("payment_date >= ? AND payment_date <= ?", start, end).
+		Order("payment_date ASC, created_at ASC").
+		Find(&docs.payments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+type journalLine struct {
+	Account     string
+	Project     string
+	Description string
+	Debit       float64
+	Credit      float64
+}
+
+type journalEntry struct {
+	Date     time.Time
+	Type     string
+	Document string
+	Client   string
+	Currency string
+	Lines    []journalLine
+}
+
+func buildJournal(docs *accountingDocuments, accounts *accountResolver) []*journalEntry {
+	var entries []*journalEntry
+
+	for _, invoice := range docs.invoices {
+		entry := &journalEntry{
+			Date:     time.Time(*invoice.IssueDate),
+			Type:     "invoice",
+			Document: invoice.Number,
+			Client:   invoice.Client.Name,
+			Currency: invoice.Currency,
+		}
+		receivable := accounts.resolve(invoice.ClientID, nil)
+		entry.Lines = append(entry.Lines, journalLine{Account: receivable.ReceivableAccount, Description: "Invoice " + invoice.Number})
+
+		var total float64
+		for _, line := range invoice.Lines {
+			amount := roundMoney(line.Amount)
+			projectID := line.ProjectID
+			entry.Lines = append(entry.Lines, journalLine{
+				Account:     accounts.resolve(invoice.ClientID, &projectID).RevenueAccount,
+				Project:     line.Project.Code,
+				Description: line.Description,
+				Credit:      amount,
+			})
+			total += amount
+		}
+		if tax := roundMoney(invoice.TaxTotal); tax != 0 {
+			entry.Lines = append(entry.Lines, journalLine{Account: receivable.TaxAccount, Description: "Tax on " + invoice.Number, Credit: tax})
+			total += tax
+		}
+		entry.Lines[0].Debit = roundMoney(total)
+		entries = append(entries, entry)
+	}
+
+	for _, note := range docs.creditNotes {
+		entry := &journalEntry{
+			Date:     time.Time(note.IssueDate),
+			Type:     "credit_note",
+			Document: note.Number,
+			Client:   note.Client.Name,
+			Currency: note.Currency,
+		}
+		receivable := accounts.resolve(note.ClientID, nil)
+		entry.Lines = append(entry.Lines, journalLine{Account: receivable.ReceivableAccount, Description: "Credit note " + note.Number + " for " + note.Invoice.Number})
+
+		var total float64
+		for _, line := range note.Lines {
+			amount := roundMoney(line.Amount)
+			projectID := line.ProjectID
+			entry.Lines = append(entry.Lines, journalLine{
+				Account:     accounts.resolve(note.ClientID, &projectID).RevenueAccount,
+				Project:     line.Project.Code,
+				Description: line.Description,
+				Debit:       amount,
+			})
+			total += amount
+		}
+		if tax := roundMoney(note.TaxTotal); tax != 0 {
+			entry.Lines = append(entry.Lines, journalLine{Account: receivable.TaxAccount, Description: "Tax on " + note.Number, Debit: tax})
+			total += tax
+		}
+		entry.Lines[0].Credit = roundMoney(total)
+		entries = append(entries, entry)
+	}
+
+	for _, payment := range docs.payments {
+		accountsForClient := accounts.resolve(payment.ClientID, nil)
+		amount := roundMoney(payment.Amount)
+		description := "Payment for " + payment.Invoice.Number
+		if payment.Reference != "" {
+			description += " (" + payment.Reference + ")"
+		}
+		entries = append(entries, &journalEntry{
+			Date:     time.Time(payment.PaymentDate),
+			Type:     "payment",
+			Document: payment.Invoice.Number,
+			Client:   payment.Client.Name,
+			Currency: payment.Currency,
+			Lines: []journalLine{
+				{Account: accountsForClient.BankAccount, Description: description, Debit: amount},
+				{Account: accountsForClient.ReceivableAccount, Description: description, Credit: amount},
+			},
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+
+	return entries
+}
+
+func (s *AccountingService) ExportAccounting(userID uuid.UUID, format AccountingExportFormat, startDate, endDate time.Time, w io.Writer) error {
+	switch format {
+	case AccountingExportIIF, AccountingExportXero, AccountingExportXeroPayments, AccountingExportJournal:
+	default:
+		return ErrInvalidExportFormat
+	}
+
+	accounts, err := loadAccountResolver(userID)
+	if err != nil {
+		return err
+	}
+	docs, err := loadAccountingDocuments(userID, startDate, endDate)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case AccountingExportXero:
+		return writeXeroInvoices(w, docs, accounts)
+	case AccountingExportXeroPayments:
+		return writeXeroPayments(w, docs, accounts)
+	}
+
+	entries := buildJournal(docs, accounts)
+	if format == AccountingExportIIF {
+		return writeIIF(w, entries)
+	}
+	return writeJournalCSV(w, entries)
+}
+
+func formatAccountingAmount(amount float64) string {
+	return strconv.FormatFloat(roundMoney(amount), 'f', 2, 64)
+}
+
+func writeJournalCSV(w io.Writer, entries []*journalEntry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"date", "entry", "type", "document", "account", "client", "project", "description", "currency", "debit", "credit"}); err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		for _, line := range entry.Lines {
+			debit, credit := "", ""
+			if line.Debit != 0 {
+				debit = formatAccountingAmount(line.Debit)
+			}
+			if line.Credit != 0 {
+				credit = formatAccountingAmount(line.Credit)
+			}
+			err := writer.Write([]string{
+				entry.Date.Format("2006-01-02"),
+				strconv.Itoa(i + 1),
+				entry.Type,
+				entry.Document,
+				line.Account,
+				entry.Client,
+				line.Project,
+				line.Description,
+				entry.Currency,
+				debit,
+				credit,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+var iifTransactionTypes = map[string]string{
+	"invoice":     "INVOICE",
+	"credit_note": "CREDIT MEMO",
+	"payment":     "PAYMENT",
+}
+
+func writeIIF(w io.Writer, entries []*journalEntry) error {
+	clean := strings.NewReplacer("\t", " ", "\r", " ", "\n", " ", "\"", "'")
+	columns := "TRNSTYPE\tDATE\tACCNT\tNAME\tCLASS\tAMOUNT\tDOCNUM\tMEMO"
+	rows := []string{
+		"!TRNS\tTRNSID\t" + columns,
+		"!SPL\tSPLID\t" + columns,
+		"!ENDTRNS",
+	}
+
+	for _, entry := range entries {
+		for i, line := range entry.Lines {
+			kind := "SPL"
+			if i == 0 {
+				kind = "TRNS"
+			}
+			rows = append(rows, strings.Join([]string{
+				kind,
+				"",
+				iifTransactionTypes[entry.Type],
+				entry.Date.Format("01/02/2006"),
+				clean.Replace(line.Account),
+				clean.Replace(entry.Client),
+				clean.Replace(line.Project),
+				formatAccountingAmount(line.Debit - line.Credit),
+				clean.Replace(entry.Document),
+				clean.Replace(line.Description),
+			}, "\t"))
+		}
+		rows = append(rows, "ENDTRNS")
+	}
+
+	_, err := io.WriteString(w, strings.Join(rows, "\r\n")+"\r\n")
+	return err
+}
+
+func xeroTaxType(taxLines []models.InvoiceTaxLine) string {
+	if len(taxLines) == 0 {
+		return "No Tax"
+	}
+	switch line := taxLines[0]; line.Treatment {
+	case models.TaxStatusExempt:
+		return "Exempt Income"
+	case models.TaxStatusReverseCharge:
+		return "Reverse Charge"
+	default:
+		return line.Name + " " + strconv.FormatFloat(line.Rate, 'f', -1, 64) + "%"
+	}
+}
+
+func splitTax(tax float64, amounts []float64) []float64 {
+	shares := make([]float64, len(amounts))
+	var subtotal float64
+	for _, amount := range amounts {
+		subtotal += amount
+	}
+	if subtotal == 0 || len(amounts) == 0 {
+		return shares
+	}
+
+	remaining := roundMoney(tax)
+	for i, amount := range amounts[:len(amounts)-1] {
+		shares[i] = roundMoney(tax * amount / subtotal)
+		remaining -= shares[i]
+	}
+	shares[len(shares)-1] = roundMoney(remaining)
+	return shares
+}
+
+func writeXeroInvoices(w io.Writer, docs *accountingDocuments, accounts *accountResolver) error {
+	writer := csv.NewWriter(w)
+	header := []string{
+		"*Type", "*ContactName", "EmailAddress", "*InvoiceNumber", "Reference", "*InvoiceDate", "*DueDate",
+		"*Description", "*Quantity", "*UnitAmount", "*AccountCode", "*TaxType", "TaxAmount",
+		"TrackingName1", "TrackingOption1", "Currency",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, invoice := range docs.invoices {
+		issueDate := time.Time(*invoice.IssueDate).Format("2006-01-02")
+		dueDate := issueDate
+		if invoice.DueDate != nil {
+			dueDate = time.Time(*invoice.DueDate).Format("2006-01-02")
+		}
+
+		amounts := make([]float64, len(invoice.Lines))
+		for i, line := range invoice.Lines {
+			amounts[i] = line.Amount
+		}
+		taxes := splitTax(invoice.TaxTotal, amounts)
+		taxType := xeroTaxType(invoice.TaxLines)
+
+		for i, line := range invoice.Lines {
+			projectID := line.ProjectID
+			err := writer.Write([]string{
+				"ACCREC",
+				invoice.Client.Name,
+				invoice.Client.Email,
+				invoice.Number,
+				invoice.Client.BuyerReference,
+				issueDate,
+				dueDate,
+				line.Description,
+				strconv.FormatFloat(line.Units(), 'f', -1, 64),
+				strconv.FormatFloat(line.Rate, 'f', -1, 64),
+				accounts.resolve(invoice.ClientID, &projectID).RevenueAccount,
+				taxType,
+				formatAccountingAmount(taxes[i]),
+				"Project",
+				line.Project.Code,
+				invoice.Currency,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, note := range docs.creditNotes {
+		issueDate := time.Time(note.IssueDate).Format("2006-01-02")
+
+		amounts := make([]float64, len(note.Lines))
+		for i, line := range note.Lines {
+			amounts[i] = line.Amount
+		}
+		taxes := splitTax(note.TaxTotal, amounts)
+		taxType := xeroTaxType(note.Invoice.TaxLines)
+
+		for i, line := range note.Lines {
+			projectID := line.ProjectID
+			err := writer.Write([]string{
+				"ACCRECCREDIT",
+				note.Client.Name,
+				note.Client.Email,
+				note.Number,
+				note.Invoice.Number,
+				issueDate,
+				issueDate,
+				line.Description,
+				strconv.FormatFloat(line.Units(), 'f', -1, 64),
+				strconv.FormatFloat(line.Rate, 'f', -1, 64),
+				accounts.resolve(note.ClientID, &projectID).RevenueAccount,
+				taxType,
+				formatAccountingAmount(taxes[i]),
+				"Project",
+				line.Project.Code,
+				note.Currency,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeXeroPayments(w io.Writer, docs *accountingDocuments, accounts *accountResolver) error {
+	writer := csv.NewWriter(w)
+	header := []string{"*InvoiceNumber", "*Date", "*Amount", "*AccountCode", "Reference", "Currency"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, payment := range docs.payments {
+		if roundMoney(payment.AppliedAmount) <= 0 {
+			continue
+		}
+		err := writer.Write([]string{
+			payment.Invoice.Number,
+			time.Time(payment.PaymentDate).Format("2006-01-02"),
+			formatAccountingAmount(payment.AppliedAmount),
+			accounts.resolve(payment.ClientID, nil).BankAccount,
+			payment.Reference,
+			payment.Currency,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -23,16 +23,8 @@ var (
 	ErrProjectNotActive   = errors.New("project is not active")
 )
 
-func (s *AllocationService) getWeekStart(date time.Time) time.Time {
-	weekday := int(date.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	return date.AddDate(0, 0, -(weekday - 1)).Truncate(24 * time.Hour)
-}
-
 func (s *AllocationService) CreateAllocation(userID, projectID uuid.UUID, weekStarting time.Time, hours float64, notes string) (*models.Allocation, error) {
-	weekStart := s.getWeekStart(weekStarting)
+	weekStart := startOfWeek(weekStarting)
 	if !weekStart.Equal(weekStarting) {
 		return nil, ErrInvalidWeekStart
 	}
@@ -61,7 +53,14 @@ func (s *AllocationService) CreateAllocation(userID, projectID uuid.UUID, weekSt
 		Notes:        notes,
 	}
 
-	if err := database.DB.Create(allocation).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(allocation).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityAllocation, allocation.ID, userID,
+			models.RevisionActionCreate, nil, allocationSnapshot(allocation))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -95,12 +94,12 @@ func (s *AllocationService) ListAllocations(userID uuid.UUID, projectID *uuid.UU
 	}
 
 	if startDate != nil {
-		weekStart := s.getWeekStart(*startDate)
+		weekStart := startOfWeek(*startDate)
 		query = query.Where("week_starting >= ?", weekStart)
 	}
 
 	if endDate != nil {
-		weekEnd := s.getWeekStart(*endDate)
+		weekEnd := startOfWeek(*endDate)
 		query = query.Where("week_starting <= ?", weekEnd)
 	}
 
@@ -116,7 +115,7 @@ func (s *AllocationService) ListAllocations(userID uuid.UUID, projectID *uuid.UU
 }
 
 func (s *AllocationService) GetWeekAllocations(userID uuid.UUID, weekStarting time.Time) ([]*models.Allocation, float64, error) {
-	weekStart := s.getWeekStart(weekStarting)
+	weekStart := startOfWeek(weekStarting)
 
 	var allocations []*models.Allocation
 	err := database.DB.Preload("Project.Client").
@@ -145,12 +144,25 @@ func (s *AllocationService) UpdateAllocation(userID, allocationID uuid.UUID, hou
 		return nil, err
 	}
 
+	before := allocationSnapshot(&allocation)
+
 	updates := map[string]interface{}{
 		"hours": hours,
 		"notes": notes,
 	}
 
-	if err := database.DB.Model(&allocation).Updates(updates).Error; err != nil {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&allocation).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		allocation.Hours = hours
+		allocation.Notes = notes
+
+		return recordRevision(tx, models.RevisionEntityAllocation, allocation.ID, userID,
+			models.RevisionActionUpdate, before, allocationSnapshot(&allocation))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -162,19 +174,38 @@ func (s *AllocationService) UpdateAllocation(userID, allocationID uuid.UUID, hou
 }
 
 func (s *AllocationService) DeleteAllocation(userID, allocationID uuid.UUID) error {
-	result := database.DB.Where("id = ? AND user_id = ?", allocationID, userID).Delete(&models.Allocation{})
-	if result.Error != nil {
-		return result.Error
+	var allocation models.Allocation
+	if err := database.DB.Where("id = ? AND user_id = ?", allocationID, userID).First(&allocation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAllocationNotFound
+		}
+		return err
 	}
-	if result.RowsAffected == 0 {
-		return ErrAllocationNotFound
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&allocation).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityAllocation, allocation.ID, userID,
+			models.RevisionActionDelete, allocationSnapshot(&allocation), nil)
+	})
+}
+
+func (s *AllocationService) GetAllocationHistory(userID, allocationID uuid.UUID) ([]*models.Revision, error) {
+	var allocation models.Allocation
+	if err := database.DB.Unscoped().Where("id = ? AND user_id = ?", allocationID, userID).First(&allocation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAllocationNotFound
+		}
+		return nil, err
 	}
-	return nil
+
+	return NewRevisionService().ListRevisions(models.RevisionEntityAllocation, allocation.ID)
 }
 
 func (s *AllocationService) CopyWeekAllocations(userID uuid.UUID, fromWeek, toWeek time.Time) ([]*models.Allocation, error) {
-	fromWeekStart := s.getWeekStart(fromWeek)
-	toWeekStart := s.getWeekStart(toWeek)
+	fromWeekStart := startOfWeek(fromWeek)
+	toWeekStart := startOfWeek(toWeek)
 
 	if !toWeekStart.Equal(toWeek) {
 		return nil, ErrInvalidWeekStart
@@ -203,7 +234,14 @@ func (s *AllocationService) CopyWeekAllocations(userID uuid.UUID, fromWeek, toWe
 			Notes:        "Copied from week of " + fromWeekStart.Format("2006-01-02"),
 		}
 
-		if err := database.DB.Create(newAllocation).Error; err == nil {
+		err = database.DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(newAllocation).Error; err != nil {
+				return err
+			}
+			return recordRevision(tx, models.RevisionEntityAllocation, newAllocation.ID, userID,
+				models.RevisionActionCreate, nil, allocationSnapshot(newAllocation))
+		})
+		if err == nil {
 			newAllocations = append(newAllocations, newAllocation)
 		}
 	}
@@ -214,3 +252,85 @@ func (s *AllocationService) CopyWeekAllocations(userID uuid.UUID, fromWeek, toWe
 
 	return newAllocations, nil
 }
+
+func (s *AllocationService) ListDeletedAllocations(userID uuid.UUID, offset, limit int) ([]*models.Allocation, int64, error) {
+	var allocations []*models.Allocation
+	var total int64
+
+	query := database.DB.Unscoped().Model(&models.Allocation{}).
+		Preload("Project", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Project.Client", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("deleted_at DESC").Find(&allocations).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return allocations, total, nil
+}
+
+func (s *AllocationService) RestoreAllocation(userID, allocationID uuid.UUID) (*models.Allocation, error) {
+	allocation, err := s.getDeletedAllocation(userID, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project models.Project
+	if err := database.DB.Where("id = ?", allocation.ProjectID).First(&project).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectDeleted
+		}
+		return nil, err
+	}
+
+	var existing models.Allocation
+	err = database.DB.Where("project_id = ? AND user_id = ? AND week_starting = ?",
+		allocation.ProjectID, userID, allocation.WeekStarting).First(&existing).Error
+	if err == nil {
+		return nil, ErrAllocationExists
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(allocation).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityAllocation, allocation.ID, userID,
+			models.RevisionActionRestore, nil, allocationSnapshot(allocation))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetAllocation(userID, allocationID)
+}
+
+func (s *AllocationService) PurgeAllocation(userID, allocationID uuid.UUID) error {
+	allocation, err := s.getDeletedAllocation(userID, allocationID)
+	if err != nil {
+		return err
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(allocation).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityAllocation, allocation.ID, userID,
+			models.RevisionActionPurge, allocationSnapshot(allocation), nil)
+	})
+}
+
+func (s *AllocationService) getDeletedAllocation(userID, allocationID uuid.UUID) (*models.Allocation, error) {
+	var allocation models.Allocation
+	err := database.DB.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", allocationID, userID).First(&allocation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAllocationNotFound
+		}
+		return nil, err
+	}
+	return &allocation, nil
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"errors"
+	"io"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/storage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+type AttachmentService struct {
+	storage storage.Storage
+}
+
+func NewAttachmentService() *AttachmentService {
+	return &AttachmentService{
+		storage: storage.NewDefaultStorage(),
+	}
+}
+
+func (s *AttachmentService) UploadAttachment(userID, timeEntryID uuid.UUID, fileName string, size int64, r io.Reader) (*models.Attachment, error) {
+	var timeEntry models.TimeEntry
+	if err := database.DB.Where("id = ? AND user_id = ?", timeEntryID, userID).First(&timeEntry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	attachment := &models.Attachment{
+		TimeEntryID: timeEntryID,
+		UserID:      userID,
+	}
+	attachment.ID = uuid.New()
+	attachment.StorageKey = timeEntryID.String() + "/" + attachment.ID.String()
+
+	file, err := saveUpload(s.storage, attachment.StorageKey, fileName, size, r)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.FileName = file.FileName
+	attachment.ContentType = file.ContentType
+	attachment.Size = file.Size
+	attachment.Checksum = file.Checksum
+
+	if err := database.DB.Create(attachment).Error; err != nil {
+		s.storage.Delete(attachment.StorageKey)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *AttachmentService) ListAttachments(userID, timeEntryID uuid.UUID) ([]*models.Attachment, error) {
+	var timeEntry models.TimeEntry
+	if err := database.DB.Where("id = ? AND user_id = ?", timeEntryID, userID).First(&timeEntry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	var attachments []*models.Attachment
+	err := database.DB.Where("time_entry_id = ?", timeEntryID).Order("created_at ASC").Find(&attachments).Error
+	return attachments, err
+}
+
+func (s *AttachmentService) GetAttachment(userID, timeEntryID, attachmentID uuid.UUID) (*models.Attachment, error) {
+	var attachment models.Attachment
+	err := database.DB.Where("id = ? AND time_entry_id = ? AND user_id = ?", attachmentID, timeEntryID, userID).First(&attachment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (s *AttachmentService) OpenAttachment(userID, timeEntryID, attachmentID uuid.UUID) (*models.Attachment, io.ReadCloser, error) {
+	attachment, err := s.GetAttachment(userID, timeEntryID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := s.storage.Open(attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, nil, ErrAttachmentNotFound
+		}
+		return nil, nil, err
+	}
+
+	return attachment, reader, nil
+}
+
+func (s *AttachmentService) DeleteAttachment(userID, timeEntryID, attachmentID uuid.UUID) error {
+	attachment, err := s.GetAttachment(userID, timeEntryID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := database.DB.Unscoped().Delete(attachment).Error; err != nil {
+		return err
+	}
+
+	s.storage.Delete(attachment.StorageKey)
+	return nil
+}
+
+func (s *AttachmentService) purgeTimeEntryAttachments(tx *gorm.DB, timeEntryID uuid.UUID) ([]string, error) {
+	var attachments []*models.Attachment
+	if err := tx.Unscoped().Where("time_entry_id = ?", timeEntryID).Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		keys[i] = attachment.StorageKey
+	}
+
+	if err := tx.Unscoped().Where("time_entry_id = ?", timeEntryID).Delete(&models.Attachment{}).Error; err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *AttachmentService) deleteStoredFiles(keys []string) {
+	for _, key := range keys {
+		s.storage.Delete(key)
+	}
+}
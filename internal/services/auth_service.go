@@ -2,10 +2,14 @@ package services
 
 import (
 	"errors"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
 	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type AuthService struct{}
@@ -19,6 +23,7 @@ var (
 	ErrUserNotActive      = errors.New("user account is not active")
 	ErrUsernameExists     = errors.New("username already exists")
 	ErrEmailExists        = errors.New("email already exists")
+	ErrInvalidTimeZone    = errors.New("unknown time zone, use an IANA name such as Europe/Berlin")
 )
 
 func (s *AuthService) Register(username, email, password, fullName string) (*models.User, error) {
@@ -38,6 +43,7 @@ func (s *AuthService) Register(username, email, password, fullName string) (*mod
 		Password: password,
 		FullName: fullName,
 		IsActive: true,
+		IsAdmin:  isAdminUsername(username),
 	}
 
 	if err := database.DB.Create(user).Error; err != nil {
@@ -73,6 +79,58 @@ func (s *AuthService) GetUserByID(userID uuid.UUID) (*models.User, error) {
 	return &user, nil
 }
 
+func (s *AuthService) UpdateProfile(userID uuid.UUID, updates map[string]interface{}) (*models.User, error) {
+	user, err := s.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeZone, ok := updates["time_zone"].(string); ok && timeZone != "" {
+		if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "Local" {
+			return nil, ErrInvalidTimeZone
+		}
+	}
+
+	if err := database.DB.Model(user).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetUserByID(userID)
+}
+
 func (s *AuthService) ValidateCredentials(username, password string) (*models.User, error) {
 	return s.Login(username, password)
 }
+
+func (s *AuthService) SyncAdmins() error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.User{}).Where("is_admin = ?", true).Update("is_admin", false).Error; err != nil {
+			return err
+		}
+
+		usernames := adminUsernames()
+		if len(usernames) == 0 {
+			return nil
+		}
+		return tx.Model(&models.User{}).Where("username IN ?", usernames).Update("is_admin", true).Error
+	})
+}
+
+func adminUsernames() []string {
+	var usernames []string
+	for _, username := range strings.Split(os.Getenv("ADMIN_USERNAMES"), ",") {
+		if username = strings.TrimSpace(username); username != "" {
+			usernames = append(usernames, username)
+		}
+	}
+	return usernames
+}
+
+func isAdminUsername(username string) bool {
+	for _, admin := range adminUsernames() {
+		if admin == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+)
+
+type BillingService struct {
+	overtimeService *OvertimeService
+}
+
+func NewBillingService() *BillingService {
+	return &BillingService{
+		overtimeService: NewOvertimeService(),
+	}
+}
+
+type ProjectBilling struct {
+	Project       models.Project
+	Rule          models.OvertimeRule
+	Split         OvertimeSplit
+	BaseAmount    float64
+	PremiumAmount float64
+}
+
+func (b *ProjectBilling) TotalAmount() float64 {
+	return b.BaseAmount + b.PremiumAmount
+}
+
+func (s *BillingService) CalculateBilling(userID uuid.UUID, startDate, endDate time.Time) ([]*ProjectBilling, error) {
+	entries, holidays, err := s.overtimeService.loadWeeks(userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[uuid.UUID][]*models.TimeEntry)
+	var projectIDs []uuid.UUID
+	for _, entry := range entries {
+		if !entry.IsBillable {
+			continue
+		}
+		clients[entry.Project.ClientID] = append(clients[entry.Project.ClientID], entry)
+		projectIDs = append(projectIDs, entry.ProjectID)
+	}
+	rates, err := loadRateResolver(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make(map[uuid.UUID]*ProjectBilling)
+	for clientID, clientEntries := range clients {
+		rule := s.overtimeService.GetBillingRule(userID, clientID)
+		splits := splitOvertime(rule, clientEntries, holidays, func(entry *models.TimeEntry) float64 {
+			return entry.BillableHours()
+		})
+
+		for i, entry := range clientEntries {
+			date := time.Time(entry.Date)
+			if date.Before(startDate) || date.After(endDate) {
+				continue
+			}
+
+			project := entry.Project
+			billing, exists := projects[project.ID]
+			if !exists {
+				billing = &ProjectBilling{Project: project, Rule: rule}
+				projects[project.ID] = billing
+			}
+
+			rate := rates.rate(&project, entry.UserID, date)
+			billing.Split.Add(splits[i])
+			billing.BaseAmount += entry.BillableHours() * rate
+			billing.PremiumAmount += splits[i].PremiumHours(rule) * rate
+		}
+	}
+
+	result := make([]*ProjectBilling, 0, len(projects))
+	for _, billing := range projects {
+		result = append(result, billing)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Project.Name < result[j].Project.Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,391 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type BudgetService struct {
+	projectService *ProjectService
+}
+
+func NewBudgetService() *BudgetService {
+	return &BudgetService{
+		projectService: NewProjectService(),
+	}
+}
+
+var (
+	ErrBudgetNotFound         = errors.New("budget not found")
+	ErrBudgetEmpty            = errors.New("a budget needs hours or an amount")
+	ErrBudgetPhaseExists      = errors.New("a budget already exists for this phase")
+	ErrInvalidBudgetThreshold = errors.New("thresholds must be between 1 and 200 percent")
+)
+
+type BudgetBurn struct {
+	Budget          models.ProjectBudget
+	WindowStart     time.Time
+	WindowEnd       *time.Time
+	LoggedHours     float64
+	LoggedValue     float64
+	PlannedHours    float64
+	PlannedValue    float64
+	RemainingHours  float64
+	RemainingAmount float64
+	ProjectedHours  float64
+	ProjectedValue  float64
+	HoursPercent    float64
+	AmountPercent   float64
+}
+
+func (b *BudgetBurn) percent(metric models.BudgetMetric) float64 {
+	if metric == models.BudgetMetricHours {
+		return b.HoursPercent
+	}
+	return b.AmountPercent
+}
+
+func (s *BudgetService) CreateBudget(userID, projectID uuid.UUID, budget *models.ProjectBudget) (*models.ProjectBudget, error) {
+	if _, err := s.projectService.GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	budget.ProjectID = projectID
+	budget.Phase = strings.TrimSpace(budget.Phase)
+	if err := s.validate(budget); err != nil {
+		return nil, err
+	}
+	if err := s.checkPhase(budget); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Create(budget).Error; err != nil {
+		return nil, err
+	}
+
+	if err := s.CheckThresholds(userID, projectID); err != nil {
+		return nil, err
+	}
+	return budget, nil
+}
+
+func (s *BudgetService) ListBudgets(userID, projectID uuid.UUID) ([]*models.ProjectBudget, error) {
+	if _, err := s.projectService.GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	var budgets []*models.ProjectBudget
+	err := database.DB.Where("project_id = ?", projectID).
+		Order("phase ASC").
+		Find(&budgets).Error
+	return budgets, err
+}
+
+func (s *BudgetService) UpdateBudget(userID, projectID, budgetID uuid.UUID, updates map[string]interface{}) (*models.ProjectBudget, error) {
+	budget, err := s.getBudget(userID, projectID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if phase, ok := updates["phase"].(string); ok {
+		budget.Phase = strings.TrimSpace(phase)
+		updates["phase"] = budget.Phase
+		if err := s.checkPhase(budget); err != nil {
+			return nil, err
+		}
+	}
+	if hours, ok := updates["hours"].(float64); ok {
+		budget.Hours = hours
+	}
+	if amount, ok := updates["amount"].(float64); ok {
+		budget.Amount = amount
+	}
+	if thresholds, ok := updates["thresholds"].([]float64); ok {
+		budget.Thresholds = thresholds
+	}
+	if err := s.validate(budget); err != nil {
+		return nil, err
+	}
+	if _, ok := updates["thresholds"]; ok {
+		updates["thresholds"] = budget.Thresholds
+	}
+
+	if err := database.DB.Model(budget).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	if err := s.CheckThresholds(userID, projectID); err != nil {
+		return nil, err
+	}
+	return s.getBudget(userID, projectID, budgetID)
+}
+
+func (s *BudgetService) DeleteBudget(userID, projectID, budgetID uuid.UUID) error {
+	budget, err := s.getBudget(userID, projectID, budgetID)
+	if err != nil {
+		return err
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("budget_id = ?", budget.ID).Delete(&models.BudgetAlert{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(budget).Error
+	})
+}
+
+func (s *BudgetService) GetBurn(userID, projectID uuid.UUID, asOf time.Time) (*models.Project, []*BudgetBurn, error) {
+	project, err := s.projectService.GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var budgets []models.ProjectBudget
+	if err := database.DB.Where("project_id = ?", projectID).Order("phase ASC").Find(&budgets).Error; err != nil {
+		return nil, nil, err
+	}
+
+	burns, err := s.calculateBurn(project, budgets, asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+	return project, burns, nil
+}
+
+func (s *BudgetService) CheckThresholds(userID, projectID uuid.UUID) error {
+	project, budgets, err := s.GetBurn(userID, projectID, time.Now().UTC().Truncate(24*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	for _, burn := range budgets {
+		err := database.DB.Transaction(func(tx *gorm.DB) error {
+			return s.recordAlerts(tx, userID, project, burn)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *BudgetService) recordAlerts(tx *gorm.DB, userID uuid.UUID, project *models.Project, burn *BudgetBurn) error {
+	var alerts []models.BudgetAlert
+	if err := tx.Where("budget_id = ?", burn.Budget.ID).Find(&alerts).Error; err != nil {
+		return err
+	}
+
+	alerted := make(map[string]bool)
+	for _, alert := range alerts {
+		if alert.Threshold > burn.percent(alert.Metric) {
+			if err := tx.Unscoped().Delete(&alert).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		alerted[fmt.Sprintf("%s:%g", alert.Metric, alert.Threshold)] = true
+	}
+
+	metrics := map[models.BudgetMetric]float64{
+		models.BudgetMetricHours:  burn.Budget.Hours,
+		models.BudgetMetricAmount: burn.Budget.Amount,
+	}
+
+	for _, metric := range []models.BudgetMetric{models.BudgetMetricHours, models.BudgetMetricAmount} {
+		if metrics[metric] <= 0 {
+			continue
+		}
+
+		var crossed float64
+		for _, threshold := range burn.Budget.Thresholds {
+			if threshold <= burn.percent(metric) && !alerted[fmt.Sprintf("%s:%g", metric, threshold)] {
+				if err := tx.Create(&models.BudgetAlert{BudgetID: burn.Budget.ID, Metric: metric, Threshold: threshold}).Error; err != nil {
+					return err
+				}
+				if threshold > crossed {
+					crossed = threshold
+				}
+			}
+		}
+		if crossed == 0 {
+			continue
+		}
+
+		budgetID := burn.Budget.ID
+		notification := &models.Notification{
+			UserID:     userID,
+			Type:       models.NotificationTypeBudgetThreshold,
+			Title:      fmt.Sprintf("%s: %s reached %g%% of %s budget", project.Name, burn.Budget.Label(), crossed, metric),
+			Message:    s.alertMessage(project, burn, metric),
+			EntityType: "project_budget",
+			EntityID:   &budgetID,
+		}
+		if err := tx.Create(notification).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BudgetService) alertMessage(project *models.Project, burn *BudgetBurn, metric models.BudgetMetric) string {
+	if metric == models.BudgetMetricHours {
+		return fmt.Sprintf("%.2f of %.2f hours used (%.1f%%), %.2f hours projected at completion",
+			burn.LoggedHours, burn.Budget.Hours, burn.HoursPercent, burn.ProjectedHours)
+	}
+	return fmt.Sprintf("%.2f of %.2f %s used (%.1f%%), %.2f %s projected at completion",
+		burn.LoggedValue, burn.Budget.Amount, project.Currency, burn.AmountPercent, burn.ProjectedValue, project.Currency)
+}
+
+func (s *BudgetService) calculateBurn(project *models.Project, budgets []models.ProjectBudget, asOf time.Time) ([]*BudgetBurn, error) {
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	rates, err := loadRateResolver([]uuid.UUID{project.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	type dailyTotal struct {
+		UserID        uuid.UUID
+		Date          datatypes.Date
+		Hours         float64
+		BillableHours float64
+	}
+
+	var logged []dailyTotal
+	err = database.DB.Model(&models.TimeEntry{}).
+		Select("user_id, date, SUM(hours) AS hours, SUM(CASE WHEN is_billable THEN hours ELSE 0 END) AS billable_hours").
+		Where("project_id = ? AND date <= ?", project.ID, datatypes.Date(asOf)).
+		Group("user_id, date").
+		Scan(&logged).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var allocations []models.Allocation
+	err = database.DB.Where("project_id = ? AND week_starting > ?", project.ID, asOf.AddDate(0, 0, -7)).
+		Find(&allocations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	burns := make([]*BudgetBurn, len(budgets))
+	for i, budget := range budgets {
+		burn := &BudgetBurn{Budget: budget, WindowStart: time.Time(project.StartDate)}
+		if budget.StartDate != nil {
+			burn.WindowStart = time.Time(*budget.StartDate)
+		}
+		if budget.EndDate != nil {
+			end := time.Time(*budget.EndDate)
+			burn.WindowEnd = &end
+		} else if project.EndDate != nil {
+			end := time.Time(*project.EndDate)
+			burn.WindowEnd = &end
+		}
+
+		inWindow := func(date time.Time) bool {
+			return !date.Before(burn.WindowStart) && (burn.WindowEnd == nil || !date.After(*burn.WindowEnd))
+		}
+
+		loggedByWeek := make(map[string]float64)
+		for _, total := range logged {
+			date := time.Time(total.Date)
+			if !inWindow(date) {
+				continue
+			}
+			burn.LoggedHours += total.Hours
+			burn.LoggedValue += total.BillableHours * rates.rate(project, total.UserID, date)
+			loggedByWeek[total.UserID.String()+startOfWeek(date).Format("2006-01-02")] += total.Hours
+		}
+
+		for _, allocation := range allocations {
+			week := allocation.WeekStarting.UTC().Truncate(24 * time.Hour)
+			if burn.WindowEnd != nil && week.After(*burn.WindowEnd) {
+				continue
+			}
+			if week.AddDate(0, 0, 6).Before(burn.WindowStart) {
+				continue
+			}
+
+			hours := allocation.Hours
+			if !week.After(asOf) {
+				hours -= loggedByWeek[allocation.UserID.String()+week.Format("2006-01-02")]
+			}
+			if hours <= 0 {
+				continue
+			}
+			burn.PlannedHours += hours
+			burn.PlannedValue += hours * rates.rate(project, allocation.UserID, week)
+		}
+
+		burn.ProjectedHours = burn.LoggedHours + burn.PlannedHours
+		burn.ProjectedValue = burn.LoggedValue + burn.PlannedValue
+		burn.RemainingHours = budget.Hours - burn.LoggedHours
+		burn.RemainingAmount = budget.Amount - burn.LoggedValue
+		if budget.Hours > 0 {
+			burn.HoursPercent = burn.LoggedHours / budget.Hours * 100
+		}
+		if budget.Amount > 0 {
+			burn.AmountPercent = burn.LoggedValue / budget.Amount * 100
+		}
+
+		burns[i] = burn
+	}
+
+	return burns, nil
+}
+
+func (s *BudgetService) validate(budget *models.ProjectBudget) error {
+	if budget.Hours <= 0 && budget.Amount <= 0 {
+		return ErrBudgetEmpty
+	}
+
+	if len(budget.Thresholds) == 0 {
+		budget.Thresholds = append([]float64(nil), models.DefaultBudgetThresholds...)
+	}
+	for _, threshold := range budget.Thresholds {
+		if threshold < 1 || threshold > 200 {
+			return ErrInvalidBudgetThreshold
+		}
+	}
+	sort.Float64s(budget.Thresholds)
+	return nil
+}
+
+func (s *BudgetService) checkPhase(budget *models.ProjectBudget) error {
+	var existing models.ProjectBudget
+	err := database.DB.Where("project_id = ? AND phase = ? AND id != ?", budget.ProjectID, budget.Phase, budget.ID).First(&existing).Error
+	if err == nil {
+		return ErrBudgetPhaseExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *BudgetService) getBudget(userID, projectID, budgetID uuid.UUID) (*models.ProjectBudget, error) {
+	if _, err := s.projectService.GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	var budget models.ProjectBudget
+	if err := database.DB.Where("id = ? AND project_id = ?", budgetID, projectID).First(&budget).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		return nil, err
+	}
+	return &budget, nil
+}
@@ -0,0 +1,11 @@
+package services
+
+import "time"
+
+func startOfWeek(date time.Time) time.Time {
+	weekday := int(date.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return date.AddDate(0, 0, -(weekday - 1)).Truncate(24 * time.Hour)
+}
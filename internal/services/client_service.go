@@ -17,26 +17,43 @@ func NewClientService() *ClientService {
 }
 
 var (
-	ErrClientNotFound   = errors.New("client not found")
-	ErrClientCodeExists = errors.New("client code already exists")
+	ErrClientNotFound    = errors.New("client not found")
+	ErrClientCodeExists  = errors.New("client code already exists")
+	ErrClientDeleted     = errors.New("client is in the trash")
+	ErrClientHasProjects = errors.New("client still has projects")
 )
 
-func (s *ClientService) CreateClient(userID uuid.UUID, name, code, email, phone, address string) (*models.Client, error) {
+func (s *ClientService) CreateClient(userID uuid.UUID, name, code, email, phone, address string, defaultRate float64, tax TaxProfile, einvoice EInvoiceProfile) (*models.Client, error) {
 	code = strings.ToUpper(strings.TrimSpace(code))
 
+	tax, err := tax.normalize()
+	if err != nil {
+		return nil, err
+	}
+	einvoice, err = einvoice.normalize()
+	if err != nil {
+		return nil, err
+	}
+
 	var existing models.Client
 	if err := database.DB.Where("code = ? AND user_id = ?", code, userID).First(&existing).Error; err == nil {
 		return nil, ErrClientCodeExists
 	}
 
 	client := &models.Client{
-		Name:     name,
-		Code:     code,
-		Email:    email,
-		Phone:    phone,
-		Address:  address,
-		IsActive: true,
-		UserID:   userID,
+		Name:           name,
+		Code:           code,
+		Email:          email,
+		Phone:          phone,
+		Address:        address,
+		DefaultRate:    defaultRate,
+		TaxID:          tax.TaxID,
+		Country:        tax.Country,
+		TaxStatus:      tax.Status,
+		PeppolID:       einvoice.PeppolID,
+		BuyerReference: einvoice.BuyerReference,
+		IsActive:       true,
+		UserID:         userID,
 	}
 
 	if err := database.DB.Create(client).Error; err != nil {
@@ -117,6 +134,32 @@ func (s *ClientService) UpdateClient(userID, clientID uuid.UUID, updates map[str
 		}
 	}
 
+	tax := clientTaxProfile(&client)
+	if taxID, ok := updates["tax_id"].(string); ok {
+		tax.TaxID = strings.TrimSpace(taxID)
+		updates["tax_id"] = tax.TaxID
+	}
+	if country, ok := updates["country"].(string); ok {
+		tax.Country = strings.ToUpper(strings.TrimSpace(country))
+		updates["country"] = tax.Country
+	}
+	if status, ok := updates["tax_status"].(string); ok {
+		tax.Status = models.TaxStatus(status)
+	}
+	if err := tax.validate(); err != nil {
+		return nil, err
+	}
+	if peppolID, ok := updates["peppol_id"].(string); ok {
+		normalized, err := normalizePeppolID(peppolID)
+		if err != nil {
+			return nil, err
+		}
+		updates["peppol_id"] = normalized
+	}
+	if reference, ok := updates["buyer_reference"].(string); ok {
+		updates["buyer_reference"] = strings.TrimSpace(reference)
+	}
+
 	if err := database.DB.Model(&client).Updates(updates).Error; err != nil {
 		return nil, err
 	}
@@ -146,3 +189,67 @@ func (s *ClientService) GetClientWithProjects(userID, clientID uuid.UUID) (*mode
 	}
 	return &client, nil
 }
+
+func (s *ClientService) ListDeletedClients(userID uuid.UUID, offset, limit int) ([]*models.Client, int64, error) {
+	var clients []*models.Client
+	var total int64
+
+	query := database.DB.Unscoped().Model(&models.Client{}).Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("deleted_at DESC").Find(&clients).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return clients, total, nil
+}
+
+func (s *ClientService) RestoreClient(userID, clientID uuid.UUID) (*models.Client, error) {
+	client, err := s.getDeletedClient(userID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing models.Client
+	if err := database.DB.Where("code = ? AND user_id = ? AND id != ?", client.Code, userID, clientID).First(&existing).Error; err == nil {
+		return nil, ErrClientCodeExists
+	}
+
+	if err := database.DB.Unscoped().Model(client).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetClientByID(userID, clientID)
+}
+
+func (s *ClientService) PurgeClient(userID, clientID uuid.UUID) error {
+	client, err := s.getDeletedClient(userID, clientID)
+	if err != nil {
+		return err
+	}
+
+	var projectCount int64
+	if err := database.DB.Unscoped().Model(&models.Project{}).Where("client_id = ?", clientID).Count(&projectCount).Error; err != nil {
+		return err
+	}
+	if projectCount > 0 {
+		return ErrClientHasProjects
+	}
+
+	return database.DB.Unscoped().Delete(client).Error
+}
+
+func (s *ClientService) getDeletedClient(userID, clientID uuid.UUID) (*models.Client, error) {
+	var client models.Client
+	err := database.DB.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", clientID, userID).First(&client).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrClientNotFound
+		}
+		return nil, err
+	}
+	return &client, nil
+}
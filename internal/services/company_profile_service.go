@@ -0,0 +1,164 @@
+package services
+
+import (
+	"errors"
+	"image"
+	"io"
+	"strings"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/storage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+type CompanyProfileService struct {
+	storage storage.Storage
+}
+
+func NewCompanyProfileService() *CompanyProfileService {
+	return &CompanyProfileService{
+		storage: storage.NewDefaultStorage(),
+	}
+}
+
+const maxLogoDimension = 2000
+
+var (
+	ErrLogoNotFound   = errors.New("logo not found")
+	ErrLogoUnreadable = errors.New("logo could not be read as an image")
+	ErrLogoTooLarge   = errors.New("logo must be at most 2000x2000 pixels")
+)
+
+var logoUploadTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+}
+
+func (s *CompanyProfileService) GetProfile(userID uuid.UUID) (*models.CompanyProfile, error) {
+	var profile models.CompanyProfile
+	err := database.DB.Where("user_id = ?", userID).First(&profile).Error
+	if err == nil {
+		return &profile, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var user models.User
+	if err := database.DB.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+
+	return &models.CompanyProfile{
+		UserID: userID,
+		Name:   user.FullName,
+		Email:  user.Email,
+	}, nil
+}
+
+func (s *CompanyProfileService) UpdateProfile(userID uuid.UUID, updates map[string]interface{}) (*models.CompanyProfile, error) {
+	profile, err := s.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if peppolID, ok := updates["peppol_id"].(string); ok {
+		normalized, err := normalizePeppolID(peppolID)
+		if err != nil {
+			return nil, err
+		}
+		updates["peppol_id"] = normalized
+	}
+	if country, ok := updates["country"].(string); ok {
+		updates["country"] = strings.ToUpper(strings.TrimSpace(country))
+	}
+
+	if profile.ID == uuid.Nil {
+		if err := database.DB.Create(profile).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if err := database.DB.Model(profile).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetProfile(userID)
+}
+
+func (s *CompanyProfileService) UploadLogo(userID uuid.UUID, fileName string, size int64, r io.Reader) (*models.CompanyProfile, error) {
+	profile, err := s.UpdateProfile(userID, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	previousKey := profile.LogoStorageKey
+	storageKey := "logos/" + userID.String() + "/" + uuid.New().String()
+
+	file, err := saveUpload(s.storage, storageKey, fileName, size, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !logoUploadTypes[file.ContentType] {
+		s.storage.Delete(storageKey)
+		return nil, ErrFileTypeForbidden
+	}
+
+	if err := s.checkLogoDimensions(storageKey); err != nil {
+		s.storage.Delete(storageKey)
+		return nil, err
+	}
+
+	if err := database.DB.Model(profile).Updates(map[string]interface{}{
+		"logo_file_name":    file.FileName,
+		"logo_content_type": file.ContentType,
+		"logo_storage_key":  storageKey,
+	}).Error; err != nil {
+		s.storage.Delete(storageKey)
+		return nil, err
+	}
+
+	if previousKey != "" {
+		s.storage.Delete(previousKey)
+	}
+
+	return s.GetProfile(userID)
+}
+
+func (s *CompanyProfileService) checkLogoDimensions(storageKey string) error {
+	reader, err := s.storage.Open(storageKey)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	config, _, err := image.DecodeConfig(reader)
+	if err != nil {
+		return ErrLogoUnreadable
+	}
+	if config.Width > maxLogoDimension || config.Height > maxLogoDimension {
+		return ErrLogoTooLarge
+	}
+	return nil
+}
+
+func (s *CompanyProfileService) OpenLogo(profile *models.CompanyProfile) (io.ReadCloser, error) {
+	if !profile.HasLogo() {
+		return nil, ErrLogoNotFound
+	}
+
+	reader, err := s.storage.Open(profile.LogoStorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrLogoNotFound
+		}
+		return nil, err
+	}
+	return reader, nil
+}
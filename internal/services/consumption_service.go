@@ -0,0 +1,184 @@
+package services
+
+import (
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ConsumptionService struct {
+	projectService *ProjectService
+}
+
+func NewConsumptionService() *ConsumptionService {
+	return &ConsumptionService{
+		projectService: NewProjectService(),
+	}
+}
+
+type FixedFeeConsumption struct {
+	Fee             float64
+	ConsumedPercent float64
+	RemainingValue  float64
+	EffectiveRate   float64
+	Milestones      []*models.ProjectMilestone
+	CompletedAmount float64
+}
+
+type RetainerPeriod struct {
+	Month          time.Time
+	IncludedHours  float64
+	RolledOver     float64
+	AvailableHours float64
+	UsedHours      float64
+	OverageHours   float64
+	OverageAmount  float64
+	CarriedForward float64
+}
+
+type RetainerConsumption struct {
+	MonthlyFee   float64
+	TotalFees    float64
+	TotalOverage float64
+	Periods      []*RetainerPeriod
+}
+
+type ProjectConsumption struct {
+	Project       models.Project
+	AsOf          time.Time
+	LoggedHours   float64
+	BillableHours float64
+	HourlyValue   float64
+	FixedFee      *FixedFeeConsumption
+	Retainer      *RetainerConsumption
+}
+
+func (s *ConsumptionService) GetProjectConsumption(userID, projectID uuid.UUID, asOf time.Time) (*ProjectConsumption, error) {
+	project, err := s.projectService.GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := loadRateResolver([]uuid.UUID{project.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	type dailyTotal struct {
+		UserID     uuid.UUID
+		Date       datatypes.Date
+		IsBillable bool
+		Hours      float64
+	}
+
+	var totals []dailyTotal
+	err = database.DB.Model(&models.TimeEntry{}).
+		Select("user_id, date, is_billable, SUM(hours) AS hours").
+		Where("project_id = ? AND date <= ?", project.ID, datatypes.Date(asOf)).
+		Group("user_id, date, is_billable").
+		Order("date ASC").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	consumption := &ProjectConsumption{Project: *project, AsOf: asOf}
+	monthlyHours := make(map[string]float64)
+	for _, total := range totals {
+		consumption.LoggedHours += total.Hours
+		if !total.IsBillable {
+			continue
+		}
+		date := time.Time(total.Date)
+		consumption.BillableHours += total.Hours
+		consumption.HourlyValue += total.Hours * rates.rate(project, total.UserID, date)
+		monthlyHours[date.Format("2006-01")] += total.Hours
+	}
+
+	switch project.BillingType {
+	case models.BillingTypeFixedFee:
+		consumption.FixedFee, err = s.fixedFeeConsumption(project, consumption)
+		if err != nil {
+			return nil, err
+		}
+	case models.BillingTypeRetainer:
+		consumption.Retainer = s.retainerConsumption(project, asOf, monthlyHours)
+	}
+
+	return consumption, nil
+}
+
+func (s *ConsumptionService) fixedFeeConsumption(project *models.Project, consumption *ProjectConsumption) (*FixedFeeConsumption, error) {
+	milestones, err := listMilestones(project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fixedFee := &FixedFeeConsumption{
+		Fee:            project.FixedFee,
+		RemainingValue: project.FixedFee - consumption.HourlyValue,
+		Milestones:     milestones,
+	}
+	if project.FixedFee > 0 {
+		fixedFee.ConsumedPercent = consumption.HourlyValue / project.FixedFee * 100
+	}
+	if consumption.BillableHours > 0 {
+		fixedFee.EffectiveRate = project.FixedFee / consumption.BillableHours
+	}
+	for _, milestone := range milestones {
+		if milestone.IsCompleted() {
+			fixedFee.CompletedAmount += milestone.Amount
+		}
+	}
+
+	return fixedFee, nil
+}
+
+func (s *ConsumptionService) retainerConsumption(project *models.Project, asOf time.Time, monthlyHours map[string]float64) *RetainerConsumption {
+	retainer := &RetainerConsumption{MonthlyFee: project.RetainerFee}
+
+	lastDay := asOf
+	if project.EndDate != nil && time.Time(*project.EndDate).Before(lastDay) {
+		lastDay = time.Time(*project.EndDate)
+	}
+
+	overageRate := project.OverageRate
+	if overageRate == 0 {
+		overageRate = project.BillableRate
+	}
+
+	start := time.Time(project.StartDate)
+	month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	carried := 0.0
+	for !month.After(lastDay) {
+		period := &RetainerPeriod{
+			Month:         month,
+			IncludedHours: project.RetainerHours,
+			RolledOver:    carried,
+			UsedHours:     monthlyHours[month.Format("2006-01")],
+		}
+		period.AvailableHours = period.IncludedHours + period.RolledOver
+
+		if period.UsedHours > period.AvailableHours {
+			period.OverageHours = period.UsedHours - period.AvailableHours
+			period.OverageAmount = period.OverageHours * overageRate
+		} else if project.RolloverPolicy == models.RolloverPolicyCarry {
+			period.CarriedForward = period.AvailableHours - period.UsedHours
+			if project.RolloverCap > 0 && period.CarriedForward > project.RolloverCap {
+				period.CarriedForward = project.RolloverCap
+			}
+		}
+
+		retainer.Periods = append(retainer.Periods, period)
+		retainer.TotalFees += project.RetainerFee
+		retainer.TotalOverage += period.OverageAmount
+
+		carried = period.CarriedForward
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return retainer
+}
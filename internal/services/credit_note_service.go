@@ -0,0 +1,350 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type CreditNoteService struct {
+	invoiceService *InvoiceService
+}
+
+func NewCreditNoteService() *CreditNoteService {
+	return &CreditNoteService{
+		invoiceService: NewInvoiceService(),
+	}
+}
+
+var (
+	ErrCreditNoteNotFound    = errors.New("credit note not found")
+	ErrInvoiceNotCreditable  = errors.New("only issued or paid invoices can be credited")
+	ErrInvoiceLineNotFound   = errors.New("invoice line not found on this invoice")
+	ErrCreditExceedsInvoice  = errors.New("credited quantity exceeds what is left on the invoice line")
+	ErrNothingToCredit       = errors.New("invoice has already been fully credited")
+	ErrInvoiceHasCreditNotes = errors.New("invoice has credit notes")
+)
+
+type CreditNoteLineInput struct {
+	InvoiceLineID uuid.UUID
+	Quantity      float64
+}
+
+func (s *CreditNoteService) CreateCreditNote(userID, invoiceID uuid.UUID, issueDate time.Time, reason string, lines []CreditNoteLineInput) (*models.CreditNote, error) {
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status == models.InvoiceStatusCredited {
+		return nil, ErrNothingToCredit
+	}
+	if invoice.Status != models.InvoiceStatusIssued && invoice.Status != models.InvoiceStatusPaid {
+		return nil, ErrInvoiceNotCreditable
+	}
+
+	invoiceLines := make(map[uuid.UUID]*models.InvoiceLine, len(invoice.Lines))
+	for i := range invoice.Lines {
+		invoiceLines[invoice.Lines[i].ID] = &invoice.Lines[i]
+	}
+
+	note := &models.CreditNote{
+		UserID:    userID,
+		ClientID:  invoice.ClientID,
+		InvoiceID: invoice.ID,
+		IssueDate: datatypes.Date(issueDate),
+		Currency:  invoice.Currency,
+		Reason:    strings.TrimSpace(reason),
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		credited, err := creditedInvoiceHours(tx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(lines) == 0 {
+			for _, line := range invoice.Lines {
+				lines = append(lines, CreditNoteLineInput{InvoiceLineID: line.ID})
+			}
+		}
+
+		requested := make(map[uuid.UUID]float64)
+		var order []uuid.UUID
+		for _, input := range lines {
+			line, exists := invoiceLines[input.InvoiceLineID]
+			if !exists {
+				return ErrInvoiceLineNotFound
+			}
+			if _, seen := requested[line.ID]; !seen {
+				order = append(order, line.ID)
+			}
+			units := input.Quantity
+			if units <= 0 {
+				units = line.Units() - credited[line.ID]
+			}
+			requested[line.ID] += units
+		}
+
+		for _, lineID := range order {
+			line := invoiceLines[lineID]
+			units := requested[lineID]
+			if units > line.Units()-credited[lineID]+0.001 {
+				return ErrCreditExceedsInvoice
+			}
+			if units <= 0.001 {
+				continue
+			}
+
+			noteLine := models.CreditNoteLine{
+				InvoiceLineID: line.ID,
+				ProjectID:     line.ProjectID,
+				Position:      len(note.Lines) + 1,
+				Type:          line.Type,
+				Description:   line.Description,
+				Rate:          line.Rate,
+				Amount:        roundMoney(units * line.Rate),
+			}
+			if line.IsTime() {
+				noteLine.Type = models.InvoiceLineTypeTime
+				noteLine.Hours = units
+				note.TotalHours += units
+			} else {
+				noteLine.Quantity = units
+			}
+			note.Lines = append(note.Lines, noteLine)
+			note.Subtotal += noteLine.Amount
+			credited[lineID] += units
+		}
+
+		if len(note.Lines) == 0 {
+			return ErrNothingToCredit
+		}
+
+		if invoice.Subtotal != 0 {
+			note.TaxTotal = roundMoney(note.Subtotal * invoice.TaxTotal / invoice.Subtotal)
+		}
+		note.Total = roundMoney(note.Subtotal + note.TaxTotal)
+		note.AppliedAmount = math.Min(note.Total, math.Max(roundMoney(invoice.BalanceDue()), 0))
+
+		value, err := nextSequenceValue(tx, userID, SequenceCreditNote)
+		if err != nil {
+			return err
+		}
+		note.Number = formatSequenceNumber("CN", value)
+
+		noteLines := note.Lines
+		note.Lines = nil
+		if err := tx.Create(note).Error; err != nil {
+			return err
+		}
+
+		for i := range noteLines {
+			line := &noteLines[i]
+			line.CreditNoteID = note.ID
+			var released int
+			var err error
+			invoiceLine := invoiceLines[line.InvoiceLineID]
+			lineCredited := invoiceLine.Units()-credited[invoiceLine.ID] <= 0.001
+			if line.Type == models.InvoiceLineTypeTime {
+				released, err = s.adjustTimeEntries(tx, userID, note, line, lineCredited)
+			} else if lineCredited {
+				released, err = s.releaseInvoiceItems(tx, invoiceLine)
+			}
+			if err != nil {
+				return err
+			}
+			line.ReleasedEntries = released
+			if err := tx.Create(line).Error; err != nil {
+				return err
+			}
+		}
+
+		if credit := roundMoney(note.CreditAmount()); credit > 0 {
+			entry := &models.ClientCredit{
+				UserID:       userID,
+				ClientID:     invoice.ClientID,
+				CreditNoteID: &note.ID,
+				Currency:     invoice.Currency,
+				Amount:       credit,
+				Date:         note.IssueDate,
+				Description:  fmt.Sprintf("Credit note %s on invoice %s", note.Number, invoice.Number),
+			}
+			if err := tx.Create(entry).Error; err != nil {
+				return err
+			}
+		}
+
+		invoice.AmountCredited = roundMoney(invoice.AmountCredited + note.AppliedAmount)
+		updates := map[string]interface{}{"amount_credited": invoice.AmountCredited}
+
+		fullyCredited := true
+		for _, line := range invoice.Lines {
+			if line.Units()-credited[line.ID] > 0.001 {
+				fullyCredited = false
+				break
+			}
+		}
+
+		switch {
+		case fullyCredited:
+			updates["status"] = models.InvoiceStatusCredited
+		case roundMoney(invoice.BalanceDue()) <= 0 && invoice.Status == models.InvoiceStatusIssued:
+			updates["status"] = models.InvoiceStatusPaid
+			updates["paid_at"] = issueDate
+		}
+
+		return tx.Model(invoice).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCreditNote(userID, note.ID)
+}
+
+func (s *CreditNoteService) adjustTimeEntries(tx *gorm.DB, userID uuid.UUID, note *models.CreditNote, line *models.CreditNoteLine, lineCredited bool) (int, error) {
+	var entries []*models.TimeEntry
+	if err := tx.Unscoped().Where("invoice_line_id = ?", line.InvoiceLineID).
+		Order("date DESC, created_at DESC").
+		Find(&entries).Error; err != nil {
+		return 0, err
+	}
+
+	remaining := line.Hours
+	released := 0
+	for _, entry := range entries {
+		if remaining <= 0.001 && !lineCredited {
+			break
+		}
+
+		before := map[string]interface{}{
+			"credited_hours":  entry.CreditedHours,
+			"invoice_id":      entry.InvoiceID,
+			"invoice_line_id": entry.InvoiceLineID,
+		}
+		var after map[string]interface{}
+
+		if billed := entry.BillableHours() - entry.CreditedHours; lineCredited || billed <= remaining+0.001 {
+			after = map[string]interface{}{
+				"credited_hours":  0,
+				"invoice_id":      nil,
+				"invoice_line_id": nil,
+			}
+			remaining -= billed
+			released++
+		} else {
+			after = map[string]interface{}{
+				"credited_hours": entry.CreditedHours + remaining,
+			}
+			remaining = 0
+		}
+
+		if err := tx.Unscoped().Model(entry).Updates(after).Error; err != nil {
+			return 0, err
+		}
+		after["credit_note"] = note.Number
+		if err := recordRevision(tx, models.RevisionEntityTimeEntry, entry.ID, userID,
+			models.RevisionActionUpdate, before, after); err != nil {
+			return 0, err
+		}
+	}
+
+	return released, nil
+}
+
+func (s *CreditNoteService) releaseInvoiceItems(tx *gorm.DB, line *models.InvoiceLine) (int, error) {
+	result := tx.Unscoped().Model(invoiceItemModel(line.Type)).
+		Where("invoice_line_id = ?", line.ID).
+		Updates(map[string]interface{}{
+			"invoice_id":      nil,
+			"invoice_line_id": nil,
+		})
+	return int(result.RowsAffected), result.Error
+}
+
+func creditedInvoiceHours(tx *gorm.DB, invoiceID uuid.UUID) (map[uuid.UUID]float64, error) {
+	type lineTotal struct {
+		InvoiceLineID uuid.UUID
+		Hours         float64
+	}
+
+	var totals []lineTotal
+	err := tx.Model(&models.CreditNoteLine{}).
+		Select("credit_note_lines.invoice_line_id, SUM(credit_note_lines.hours + credit_note_lines.quantity) AS hours").
+		Joins("JOIN credit_notes ON credit_notes.id = credit_note_lines.credit_note_id AND credit_notes.deleted_at IS NULL").
+		Where("credit_notes.invoice_id = ?", invoiceID).
+		Group("credit_note_lines.invoice_line_id").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	credited := make(map[uuid.UUID]float64, len(totals))
+	for _, total := range totals {
+		credited[total.InvoiceLineID] = total.Hours
+	}
+	return credited, nil
+}
+
+func (s *CreditNoteService) GetCreditNote(userID, creditNoteID uuid.UUID) (*models.CreditNote, error) {
+	var note models.CreditNote
+	err := database.DB.Preload("Client").Preload("Invoice").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("id = ? AND user_id = ?", creditNoteID, userID).
+		First(&note).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCreditNoteNotFound
+		}
+		return nil, err
+	}
+	return &note, nil
+}
+
+func (s *CreditNoteService) ListInvoiceCreditNotes(userID, invoiceID uuid.UUID) ([]*models.CreditNote, error) {
+	if _, err := s.invoiceService.GetInvoice(userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	var notes []*models.CreditNote
+	err := database.DB.Preload("Client").Preload("Invoice").Preload("Lines").
+		Where("user_id = ? AND invoice_id = ?", userID, invoiceID).
+		Order("issue_date ASC, created_at ASC").
+		Find(&notes).Error
+	return notes, err
+}
+
+func (s *CreditNoteService) ListCreditNotes(userID uuid.UUID, clientID *uuid.UUID, offset, limit int) ([]*models.CreditNote, int64, error) {
+	var notes []*models.CreditNote
+	var total int64
+
+	query := database.DB.Model(&models.CreditNote{}).Where("user_id = ?", userID)
+	if clientID != nil {
+		query = query.Where("client_id = ?", *clientID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Client").Preload("Invoice").
+		Offset(offset).Limit(limit).
+		Order("issue_date DESC, created_at DESC").
+		Find(&notes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notes, total, nil
+}
@@ -0,0 +1,459 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/pdf"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type DocumentService struct {
+	invoiceService *InvoiceService
+	profileService *CompanyProfileService
+}
+
+func NewDocumentService() *DocumentService {
+	return &DocumentService{
+		invoiceService: NewInvoiceService(),
+		profileService: NewCompanyProfileService(),
+	}
+}
+
+const (
+	documentMargin = 50.0
+	documentBottom = pdf.PageHeight - 60
+	documentRight  = pdf.PageWidth - documentMargin
+)
+
+type documentLayout struct {
+	doc     *pdf.Document
+	page    *pdf.Page
+	y       float64
+	header  func(l *documentLayout)
+	company *models.CompanyProfile
+}
+
+func (l *documentLayout) newPage() {
+	l.page = l.doc.AddPage()
+	l.y = documentMargin + 10
+	if l.header != nil {
+		l.header(l)
+	}
+}
+
+func (l *documentLayout) ensureSpace(height float64) {
+	if l.y+height > documentBottom {
+		l.newPage()
+	}
+}
+
+func (l *documentLayout) finish(footer string) {
+	pages := l.doc.Pages()
+	for i, page := range pages {
+		page.Line(documentMargin, documentBottom+15, documentRight, documentBottom+15, 0.5)
+		page.Text(documentMargin, documentBottom+30, pdf.Helvetica, 8, footer)
+		page.TextRight(documentRight, documentBottom+30, pdf.Helvetica, 8, fmt.Sprintf("Page %d of %d", i+1, len(pages)))
+	}
+}
+
+func (s *DocumentService) RenderInvoice(userID, invoiceID uuid.UUID, w io.Writer) (*models.Invoice, error) {
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileService.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := "Invoice " + invoice.Number
+	if invoice.Status == models.InvoiceStatusDraft {
+		title = "Draft invoice"
+	}
+
+	layout := &documentLayout{doc: pdf.New(title), company: profile}
+	layout.newPage()
+	s.drawLetterhead(layout)
+
+	heading := "INVOICE"
+	if invoice.Status == models.InvoiceStatusDraft {
+		heading = "DRAFT INVOICE"
+	} else if invoice.Status == models.InvoiceStatusVoid {
+		heading = "VOID INVOICE"
+	}
+	layout.page.Text(documentMargin, layout.y, pdf.HelveticaBold, 20, heading)
+	layout.y += 24
+
+	details := [][2]string{}
+	if invoice.Number != "" {
+		details = append(details, [2]string{"Invoice number", invoice.Number})
+	}
+	if invoice.IssueDate != nil {
+		details = append(details, [2]string{"Issue date", formatDocumentDate(time.Time(*invoice.IssueDate))})
+	}
+	if invoice.DueDate != nil {
+		details = append(details, [2]string{"Due date", formatDocumentDate(time.Time(*invoice.DueDate))})
+	}
+	details = append(details, [2]string{"Period", formatDocumentDate(time.Time(invoice.PeriodStart)) + " - " + formatDocumentDate(time.Time(invoice.PeriodEnd))})
+	if invoice.ClientTaxID != "" {
+		details = append(details, [2]string{"Customer tax ID", invoice.ClientTaxID})
+	}
+
+	top := layout.y
+	s.drawClientBlock(layout, &invoice.Client)
+	clientBottom := layout.y
+
+	layout.y = top
+	for _, detail := range details {
+		layout.page.Text(340, layout.y, pdf.HelveticaBold, 9, detail[0])
+		layout.page.TextRight(documentRight, layout.y, pdf.Helvetica, 9, detail[1])
+		layout.y += 13
+	}
+	layout.y = math.Max(layout.y, clientBottom) + 20
+
+	columns := []float64{documentMargin, 330, 400, 470}
+	drawTableHeader := func(l *documentLayout) {
+		l.page.FillRect(documentMargin, l.y-11, documentRight-documentMargin, 16, 0.92)
+		l.page.Text(columns[0]+4, l.y, pdf.HelveticaBold, 9, "Description")
+		l.page.TextRight(columns[2]-6, l.y, pdf.HelveticaBold, 9, "Qty")
+		l.page.TextRight(columns[3]-6, l.y, pdf.HelveticaBold, 9, "Rate")
+		l.page.TextRight(documentRight-4, l.y, pdf.HelveticaBold, 9, "Amount")
+		l.y += 20
+	}
+	layout.header = drawTableHeader
+	drawTableHeader(layout)
+
+	type projectGroup struct {
+		name   string
+		lines  []models.InvoiceLine
+		hours  float64
+		amount float64
+	}
+
+	var groups []*projectGroup
+	byProject := make(map[uuid.UUID]*projectGroup)
+	for _, line := range invoice.Lines {
+		group, exists := byProject[line.ProjectID]
+		if !exists {
+			group = &projectGroup{name: line.Project.Name}
+			if line.Project.Code != "" {
+				group.name = line.Project.Name + " (" + line.Project.Code + ")"
+			}
+			byProject[line.ProjectID] = group
+			groups = append(groups, group)
+		}
+		group.lines = append(group.lines, line)
+		group.hours += line.Hours
+		group.amount += line.Amount
+	}
+
+	for _, group := range groups {
+		layout.ensureSpace(30)
+		layout.page.Text(documentMargin+4, layout.y, pdf.HelveticaBold, 10, group.name)
+		layout.y += 15
+
+		for _, line := range group.lines {
+			description := strings.TrimPrefix(line.Description, line.Project.Name+" - ")
+			if description == line.Project.Name {
+				description = "Professional services"
+			}
+			wrapped := pdf.Wrap(description, pdf.Helvetica, 9, columns[1]-columns[0]-16)
+			layout.ensureSpace(float64(len(wrapped)) * 12)
+
+			layout.page.TextRight(columns[2]-6, layout.y, pdf.Helvetica, 9, formatDocumentHours(line.Units()))
+			layout.page.TextRight(columns[3]-6, layout.y, pdf.Helvetica, 9, formatDocumentAmount(line.Rate))
+			layout.page.TextRight(documentRight-4, layout.y, pdf.Helvetica, 9, formatDocumentAmount(line.Amount))
+			for _, text := range wrapped {
+				layout.page.Text(documentMargin+14, layout.y, pdf.Helvetica, 9, text)
+				layout.y += 12
+			}
+		}
+
+		layout.page.Line(columns[1], layout.y-8, documentRight, layout.y-8, 0.3)
+		layout.page.Text(columns[1], layout.y+2, pdf.Helvetica, 8, "Project subtotal")
+		layout.page.TextRight(columns[2]-6, layout.y+2, pdf.HelveticaBold, 9, formatDocumentHours(group.hours))
+		layout.page.TextRight(documentRight-4, layout.y+2, pdf.HelveticaBold, 9, formatDocumentAmount(group.amount))
+		layout.y += 22
+	}
+
+	layout.header = nil
+	layout.ensureSpace(60 + float64(len(invoice.TaxLines))*16)
+	layout.page.Line(columns[2], layout.y-6, documentRight, layout.y-6, 0.8)
+	layout.y += 8
+	layout.page.Text(columns[2], layout.y, pdf.Helvetica, 10, "Subtotal")
+	layout.page.TextRight(documentRight-4, layout.y, pdf.Helvetica, 10, formatDocumentMoney(invoice.Subtotal, invoice.Currency))
+	layout.y += 16
+	for _, line := range invoice.TaxLines {
+		label := line.Name
+		if line.Treatment == models.TaxStatusStandard {
+			label += " " + strconv.FormatFloat(line.Rate, 'f', -1, 64) + "%"
+		}
+		if line.Country != "" {
+			label += " (" + line.Country + ")"
+		}
+		layout.page.Text(columns[2], layout.y, pdf.Helvetica, 10, label)
+		layout.page.TextRight(documentRight-4, layout.y, pdf.Helvetica, 10, formatDocumentMoney(line.Amount, invoice.Currency))
+		layout.y += 16
+	}
+	layout.page.Text(columns[2], layout.y, pdf.HelveticaBold, 11, "Total due")
+	layout.page.TextRight(documentRight-4, layout.y, pdf.HelveticaBold, 11, formatDocumentMoney(invoice.Total, invoice.Currency))
+	layout.y += 30
+
+	s.drawParagraph(layout, "Tax", invoice.TaxNote)
+	s.drawParagraph(layout, "Notes", invoice.Notes)
+	s.drawParagraph(layout, "Payment details", profile.BankDetails)
+
+	layout.finish(s.footerText(profile))
+	_, err = layout.doc.WriteTo(w)
+	return invoice, err
+}
+
+func (s *DocumentService) RenderClientTimesheet(userID, clientID uuid.UUID, startDate, endDate time.Time, w io.Writer) (*models.Client, error) {
+	var client models.Client
+	if err := database.DB.Where("id = ? AND user_id = ?", clientID, userID).First(&client).Error; err != nil {
+		return nil, ErrClientNotFound
+	}
+
+	profile, err := s.profileService.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*models.TimeEntry
+	err = database.DB.Preload("Project").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Where("projects.user_id = ? AND projects.client_id = ?", userID, clientID).
+		Where("time_entries.date >= ? AND time_entries.date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+		Order("time_entries.date ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Project.Name < entries[j].Project.Name
+	})
+
+	layout := &documentLayout{doc: pdf.New("Timesheet " + client.Name), company: profile}
+	layout.newPage()
+	s.drawLetterhead(layout)
+
+	layout.page.Text(documentMargin, layout.y, pdf.HelveticaBold, 20, "TIMESHEET")
+	layout.y += 24
+
+	top := layout.y
+	s.drawClientBlock(layout, &client)
+	clientBottom := layout.y
+
+	layout.y = top
+	layout.page.Text(340, layout.y, pdf.HelveticaBold, 9, "Period")
+	layout.page.TextRight(documentRight, layout.y, pdf.Helvetica, 9, formatDocumentDate(startDate)+" - "+formatDocumentDate(endDate))
+	layout.y += 13
+	layout.page.Text(340, layout.y, pdf.HelveticaBold, 9, "Prepared")
+	layout.page.TextRight(documentRight, layout.y, pdf.Helvetica, 9, formatDocumentDate(time.Now()))
+	layout.y = math.Max(layout.y+13, clientBottom) + 20
+
+	columns := []float64{documentMargin, 130, 430, 490}
+	drawTableHeader := func(l *documentLayout) {
+		l.page.FillRect(documentMargin, l.y-11, documentRight-documentMargin, 16, 0.92)
+		l.page.Text(columns[0]+4, l.y, pdf.HelveticaBold, 9, "Date")
+		l.page.Text(columns[1], l.y, pdf.HelveticaBold, 9, "Description")
+		l.page.TextRight(columns[3]-6, l.y, pdf.HelveticaBold, 9, "Hours")
+		l.page.TextRight(documentRight-4, l.y, pdf.HelveticaBold, 9, "Billable")
+		l.y += 20
+	}
+	layout.header = drawTableHeader
+	drawTableHeader(layout)
+
+	if len(entries) == 0 {
+		layout.page.Text(documentMargin+4, layout.y, pdf.Helvetica, 9, "No time recorded for this period.")
+		layout.y += 20
+	}
+
+	var totalHours, billableHours, projectHours float64
+	for i, entry := range entries {
+		if i == 0 || entry.ProjectID != entries[i-1].ProjectID {
+			layout.ensureSpace(30)
+			layout.page.Text(documentMargin+4, layout.y, pdf.HelveticaBold, 10, entry.Project.Name+" ("+entry.Project.Code+")")
+			layout.y += 15
+			projectHours = 0
+		}
+
+		wrapped := pdf.Wrap(entry.Description, pdf.Helvetica, 9, columns[2]-columns[1])
+		layout.ensureSpace(float64(len(wrapped)) * 12)
+
+		billable := "No"
+		if entry.IsBillable {
+			billable = "Yes"
+			billableHours += entry.Hours
+		}
+		layout.page.Text(columns[0]+4, layout.y, pdf.Helvetica, 9, formatDocumentDate(time.Time(entry.Date)))
+		layout.page.TextRight(columns[3]-6, layout.y, pdf.Helvetica, 9, formatDocumentHours(entry.Hours))
+		layout.page.TextRight(documentRight-4, layout.y, pdf.Helvetica, 9, billable)
+		for _, text := range wrapped {
+			layout.page.Text(columns[1], layout.y, pdf.Helvetica, 9, text)
+			layout.y += 12
+		}
+
+		projectHours += entry.Hours
+		totalHours += entry.Hours
+
+		if i == len(entries)-1 || entries[i+1].ProjectID != entry.ProjectID {
+			layout.page.Line(columns[2], layout.y-8, documentRight, layout.y-8, 0.3)
+			layout.page.Text(columns[2], layout.y+2, pdf.Helvetica, 8, "Project total")
+			layout.page.TextRight(columns[3]-6, layout.y+2, pdf.HelveticaBold, 9, formatDocumentHours(projectHours))
+			layout.y += 22
+		}
+	}
+
+	layout.header = nil
+	layout.ensureSpace(40)
+	layout.page.Line(columns[2], layout.y-6, documentRight, layout.y-6, 0.8)
+	layout.y += 8
+	layout.page.Text(columns[2], layout.y, pdf.HelveticaBold, 10, "Total hours")
+	layout.page.TextRight(documentRight-4, layout.y, pdf.HelveticaBold, 10, formatDocumentHours(totalHours))
+	layout.y += 15
+	layout.page.Text(columns[2], layout.y, pdf.Helvetica, 10, "Billable hours")
+	layout.page.TextRight(documentRight-4, layout.y, pdf.Helvetica, 10, formatDocumentHours(billableHours))
+
+	layout.finish(s.footerText(profile))
+	_, err = layout.doc.WriteTo(w)
+	return &client, err
+}
+
+func (s *DocumentService) drawLetterhead(l *documentLayout) {
+	top := l.y
+	logoBottom := top
+
+	if reader, err := s.profileService.OpenLogo(l.company); err == nil {
+		img, err := l.doc.LoadImage(reader)
+		reader.Close()
+		if err == nil && img.Width > 0 && img.Height > 0 {
+			scale := math.Min(160/float64(img.Width), 60/float64(img.Height))
+			width := float64(img.Width) * scale
+			height := float64(img.Height) * scale
+			l.page.Image(img, documentMargin, top-10, width, height)
+			logoBottom = top - 10 + height
+		}
+	}
+
+	y := top
+	if l.company.Name != "" {
+		l.page.TextRight(documentRight, y, pdf.HelveticaBold, 12, l.company.Name)
+		y += 15
+	}
+
+	for _, line := range companyLines(l.company) {
+		l.page.TextRight(documentRight, y, pdf.Helvetica, 9, line)
+		y += 11
+	}
+
+	l.y = math.Max(y, logoBottom) + 25
+}
+
+func (s *DocumentService) drawClientBlock(l *documentLayout, client *models.Client) {
+	l.page.Text(documentMargin, l.y, pdf.HelveticaBold, 9, "BILL TO")
+	l.y += 14
+	l.page.Text(documentMargin, l.y, pdf.HelveticaBold, 11, client.Name)
+	l.y += 13
+
+	for _, line := range splitAddress(client.Address) {
+		l.page.Text(documentMargin, l.y, pdf.Helvetica, 9, line)
+		l.y += 11
+	}
+	if client.Email != "" {
+		l.page.Text(documentMargin, l.y, pdf.Helvetica, 9, client.Email)
+		l.y += 11
+	}
+}
+
+func (s *DocumentService) drawParagraph(l *documentLayout, title, text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	lines := pdf.Wrap(text, pdf.Helvetica, 9, documentRight-documentMargin)
+	l.ensureSpace(float64(len(lines))*11 + 20)
+	l.page.Text(documentMargin, l.y, pdf.HelveticaBold, 9, title)
+	l.y += 13
+	for _, line := range lines {
+		l.page.Text(documentMargin, l.y, pdf.Helvetica, 9, line)
+		l.y += 11
+	}
+	l.y += 12
+}
+
+func (s *DocumentService) footerText(profile *models.CompanyProfile) string {
+	parts := []string{}
+	for _, part := range []string{profile.Name, profile.Website, profile.Email} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " | ")
+}
+
+func companyLines(profile *models.CompanyProfile) []string {
+	lines := splitAddress(profile.Address)
+	for _, line := range []string{profile.Phone, profile.Email, profile.Website} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if profile.TaxID != "" {
+		lines = append(lines, "Tax ID: "+profile.TaxID)
+	}
+	return lines
+}
+
+func splitAddress(address string) []string {
+	var lines []string
+	for _, line := range strings.FieldsFunc(address, func(r rune) bool { return r == '\n' || r == ';' }) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func formatDocumentDate(date time.Time) string {
+	return date.Format("02 Jan 2006")
+}
+
+func formatDocumentHours(hours float64) string {
+	return fmt.Sprintf("%.2f", hours)
+}
+
+func formatDocumentAmount(amount float64) string {
+	negative := amount < 0
+	cents := int64(math.Round(math.Abs(amount) * 100))
+	whole := fmt.Sprintf("%d", cents/100)
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	formatted := fmt.Sprintf("%s.%02d", grouped.String(), cents%100)
+	if negative {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
+
+func formatDocumentMoney(amount float64, currency string) string {
+	return currency + " " + formatDocumentAmount(amount)
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/ubl"
+	"github.com/google/uuid"
+)
+
+type EInvoiceService struct {
+	invoiceService *InvoiceService
+	profileService *CompanyProfileService
+}
+
+func NewEInvoiceService() *EInvoiceService {
+	return &EInvoiceService{
+		invoiceService: NewInvoiceService(),
+		profileService: NewCompanyProfileService(),
+	}
+}
+
+var (
+	ErrInvalidPeppolID      = errors.New("peppol ID must look like 0088:1234567890123")
+	ErrInvoiceNotExportable = errors.New("only issued invoices can be exported")
+	ErrEInvoiceInvalid      = errors.New("invoice does not meet Peppol BIS Billing 3.0")
+)
+
+type EInvoiceValidationError struct {
+	Problems []string
+}
+
+func (e *EInvoiceValidationError) Error() string {
+	return ErrEInvoiceInvalid.Error() + ": " + strings.Join(e.Problems, "; ")
+}
+
+func (e *EInvoiceValidationError) Is(target error) bool {
+	return target == ErrEInvoiceInvalid
+}
+
+type EInvoiceProfile struct {
+	PeppolID       string
+	BuyerReference string
+}
+
+var (
+	peppolIDPattern = regexp.MustCompile(`^[0-9]{4}:[^\s:]+$`)
+	ibanPattern     = regexp.MustCompile(`\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`)
+)
+
+func normalizePeppolID(id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return "", nil
+	}
+	if !peppolIDPattern.MatchString(id) {
+		return "", ErrInvalidPeppolID
+	}
+	return id, nil
+}
+
+func (p EInvoiceProfile) normalize() (EInvoiceProfile, error) {
+	var err error
+	p.PeppolID, err = normalizePeppolID(p.PeppolID)
+	p.BuyerReference = strings.TrimSpace(p.BuyerReference)
+	return p, err
+}
+
+func (s *EInvoiceService) ExportInvoice(userID, invoiceID uuid.UUID, w io.Writer) (*models.Invoice, error) {
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	switch invoice.Status {
+	case models.InvoiceStatusIssued, models.InvoiceStatusPaid, models.InvoiceStatusCredited:
+	default:
+		return nil, ErrInvoiceNotExportable
+	}
+
+	profile, err := s.profileService.GetProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, problems := buildUBLInvoice(invoice, profile)
+	if len(problems) == 0 {
+		problems = document.Validate()
+	}
+	if len(problems) > 0 {
+		return nil, &EInvoiceValidationError{Problems: problems}
+	}
+
+	var buf bytes.Buffer
+	if err := document.Encode(&buf); err != nil {
+		return nil, err
+	}
+	if problems := ubl.ValidateSchema(bytes.NewReader(buf.Bytes())); len(problems) > 0 {
+		return nil, &EInvoiceValidationError{Problems: problems}
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func buildUBLInvoice(invoice *models.Invoice, profile *models.CompanyProfile) (*ubl.Invoice, []string) {
+	var problems []string
+	client := &invoice.Client
+	currency := invoice.Currency
+
+	document := ubl.NewInvoice()
+	document.ID = invoice.Number
+	document.DocumentCurrencyCode = currency
+	document.BuyerReference = client.BuyerReference
+	if invoice.IssueDate != nil {
+		document.IssueDate = time.Time(*invoice.IssueDate).Format("2006-01-02")
+	}
+	if invoice.DueDate != nil {
+		document.DueDate = time.Time(*invoice.DueDate).Format("2006-01-02")
+	} else {
+		document.PaymentTerms = &ubl.PaymentTerms{Note: "Payable on receipt"}
+	}
+	document.InvoicePeriod = &ubl.Period{
+		StartDate: time.Time(invoice.PeriodStart).Format("2006-01-02"),
+		EndDate:   time.Time(invoice.PeriodEnd).Format("2006-01-02"),
+	}
+
+	var notes []string
+	for _, note := range []string{invoice.Notes, invoice.TaxNote} {
+		if note = strings.TrimSpace(note); note != "" {
+			notes = append(notes, note)
+		}
+	}
+	document.Note = strings.Join(notes, "\n")
+
+	seller := ubl.Party{
+		EndpointID:       peppolEndpoint(profile.PeppolID),
+		PartyName:        &ubl.PartyName{Name: profile.Name},
+		PostalAddress:    ublAddress(profile.Address, profile.Country),
+		PartyLegalEntity: ubl.PartyLegalEntity{RegistrationName: profile.Name},
+	}
+	if profile.TaxID != "" {
+		seller.PartyTaxScheme = &ubl.PartyTaxScheme{CompanyID: profile.TaxID, TaxScheme: ubl.TaxScheme{ID: ubl.TaxSchemeVAT}}
+	}
+	if profile.Email != "" || profile.Phone != "" {
+		seller.Contact = &ubl.Contact{Telephone: profile.Phone, ElectronicMail: profile.Email}
+	}
+	document.AccountingSupplierParty.Party = seller
+
+	buyer := ubl.Party{
+		EndpointID:       peppolEndpoint(client.PeppolID),
+		PartyName:        &ubl.PartyName{Name: client.Name},
+		PostalAddress:    ublAddress(client.Address, client.Country),
+		PartyLegalEntity: ubl.PartyLegalEntity{RegistrationName: client.Name},
+	}
+	if invoice.ClientTaxID != "" {
+		buyer.PartyTaxScheme = &ubl.PartyTaxScheme{CompanyID: invoice.ClientTaxID, TaxScheme: ubl.TaxScheme{ID: ubl.TaxSchemeVAT}}
+	}
+	if client.Email != "" || client.Phone != "" {
+		buyer.Contact = &ubl.Contact{Telephone: client.Phone, ElectronicMail: client.Email}
+	}
+	document.AccountingCustomerParty.Party = buyer
+
+	if iban := findIBAN(profile.BankDetails); iban != "" {
+		document.PaymentMeans = []ubl.PaymentMeans{{
+			PaymentMeansCode:      ubl.PaymentMeansTransfer,
+			PaymentID:             invoice.Number,
+			PayeeFinancialAccount: &ubl.FinancialAccount{ID: iban, Name: profile.Name},
+		}}
+	}
+
+	if len(invoice.TaxLines) == 0 {
+		problems = append(problems, "BR-CO-18: invoice has no tax breakdown, set the client's country and tax status")
+		return document, problems
+	}
+	category := ublTaxCategory(&invoice.TaxLines[0])
+
+	var lineTotal int64
+	for _, line := range invoice.Lines {
+		amount := int64(math.Round(line.Amount * 100))
+		lineTotal += amount
+
+		item := ubl.Item{
+			Name:                  line.Description,
+			ClassifiedTaxCategory: ubl.TaxCategory{ID: category.ID, Percent: category.Percent, TaxScheme: category.TaxScheme},
+		}
+		if line.Project.Code != "" {
+			item.SellersItemIdentification = &ubl.ItemIdentification{ID: line.Project.Code}
+		}
+
+		var note string
+		if line.Project.Name != line.Description {
+			note = line.Project.Name
+		}
+
+		document.InvoiceLines = append(document.InvoiceLines, ubl.InvoiceLine{
+			ID:                  strconv.Itoa(line.Position),
+			Note:                note,
+			InvoicedQuantity:    ubl.NewQuantity(line.Units(), ublUnit(&line)),
+			LineExtensionAmount: ublAmount(amount, currency),
+			Item:                item,
+			Price:               ubl.Price{PriceAmount: ubl.NewAmount(line.Rate, currency)},
+		})
+	}
+
+	taxAmount := int64(math.Round(invoice.TaxTotal * 100))
+	taxTotal := ubl.TaxTotal{TaxAmount: ublAmount(taxAmount, currency)}
+	for _, taxLine := range invoice.TaxLines {
+		taxTotal.TaxSubtotals = append(taxTotal.TaxSubtotals, ubl.TaxSubtotal{
+			TaxableAmount: ubl.NewAmount(taxLine.TaxableAmount, currency),
+			TaxAmount:     ubl.NewAmount(taxLine.Amount, currency),
+			TaxCategory:   ublTaxCategory(&taxLine),
+		})
+	}
+	document.TaxTotal = []ubl.TaxTotal{taxTotal}
+
+	inclusive := lineTotal + taxAmount
+	prepaid := int64(math.Round((invoice.AmountPaid + invoice.AmountCredited) * 100))
+	if prepaid > inclusive {
+		prepaid = inclusive
+	}
+	document.LegalMonetaryTotal = ubl.MonetaryTotal{
+		LineExtensionAmount: ublAmount(lineTotal, currency),
+		TaxExclusiveAmount:  ublAmount(lineTotal, currency),
+		TaxInclusiveAmount:  ublAmount(inclusive, currency),
+		PayableAmount:       ublAmount(inclusive-prepaid, currency),
+	}
+	if prepaid > 0 {
+		amount := ublAmount(prepaid, currency)
+		document.LegalMonetaryTotal.PrepaidAmount = &amount
+	}
+
+	return document, problems
+}
+
+func ublTaxCategory(line *models.InvoiceTaxLine) ubl.TaxCategory {
+	category := ubl.TaxCategory{
+		Percent:   strconv.FormatFloat(line.Rate, 'f', -1, 64),
+		TaxScheme: ubl.TaxScheme{ID: ubl.TaxSchemeVAT},
+	}
+	switch line.Treatment {
+	case models.TaxStatusExempt:
+		category.ID = ubl.TaxCategoryExempt
+		category.Percent = "0"
+		category.TaxExemptionReason = "Exempt from tax"
+	case models.TaxStatusReverseCharge:
+		category.ID = ubl.TaxCategoryReverseCharge
+		category.Percent = "0"
+		category.TaxExemptionReasonCode = "VATEX-EU-AE"
+		category.TaxExemptionReason = "Reverse charge"
+	default:
+		category.ID = ubl.TaxCategoryStandard
+		if line.Rate == 0 {
+			category.ID = ubl.TaxCategoryZero
+		}
+	}
+	return category
+}
+
+func peppolEndpoint(id string) ubl.Identifier {
+	scheme, value, found := strings.Cut(id, ":")
+	if !found {
+		return ubl.Identifier{}
+	}
+	return ubl.Identifier{SchemeID: scheme, Value: value}
+}
+
+func ublAddress(address, country string) ubl.PostalAddress {
+	postal := ubl.PostalAddress{Country: ubl.Country{IdentificationCode: strings.ToUpper(country)}}
+	lines := splitAddress(address)
+	if len(lines) > 0 {
+		postal.StreetName = lines[0]
+	}
+	if len(lines) > 1 {
+		postal.AdditionalStreetName = lines[1]
+	}
+	if len(lines) > 2 {
+		postal.AddressLine = &ubl.AddressLine{Line: strings.Join(lines[2:], ", ")}
+	}
+	return postal
+}
+
+func ublAmount(cents int64, currency string) ubl.Amount {
+	return ubl.NewAmount(float64(cents)/100, currency)
+}
+
+func findIBAN(bankDetails string) string {
+	match := ibanPattern.FindString(strings.ToUpper(bankDetails))
+	return strings.ReplaceAll(match, " ", "")
+}
+
+func ublUnit(line *models.InvoiceLine) string {
+	switch line.Unit {
+	case "km":
+		return ubl.UnitKilometre
+	case "mi":
+		return ubl.UnitMile
+	case "day":
+		return ubl.UnitDay
+	case "item":
+		return ubl.UnitOne
+	}
+	return ubl.UnitHour
+}
@@ -0,0 +1,344 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ExchangeRateService struct{}
+
+func NewExchangeRateService() *ExchangeRateService {
+	return &ExchangeRateService{}
+}
+
+var (
+	ErrExchangeRateNotFound    = errors.New("exchange rate not found")
+	ErrSameCurrencyPair        = errors.New("base and quote currency must differ")
+	ErrInvalidExchangeRateFile = errors.New("invalid exchange rate file")
+)
+
+type ExchangeRateImport struct {
+	Format   models.ExchangeRateSource
+	Imported int
+	Updated  int
+}
+
+func (s *ExchangeRateService) SetRate(baseCurrency, quoteCurrency string, rate float64, date time.Time) (*models.ExchangeRate, error) {
+	exchangeRate := &models.ExchangeRate{
+		BaseCurrency:  strings.ToUpper(baseCurrency),
+		QuoteCurrency: strings.ToUpper(quoteCurrency),
+		Date:          datatypes.Date(date),
+		Rate:          rate,
+		Source:        models.ExchangeRateSourceManual,
+	}
+	if exchangeRate.BaseCurrency == exchangeRate.QuoteCurrency {
+		return nil, ErrSameCurrencyPair
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := upsertExchangeRate(tx, exchangeRate)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exchangeRate, nil
+}
+
+func (s *ExchangeRateService) ListRates(baseCurrency, quoteCurrency *string, startDate, endDate *time.Time) ([]*models.ExchangeRate, error) {
+	var rates []*models.ExchangeRate
+
+	query := database.DB.Model(&models.ExchangeRate{})
+	if baseCurrency != nil {
+		query = query.Where("base_currency = ?", strings.ToUpper(*baseCurrency))
+	}
+	if quoteCurrency != nil {
+		query = query.Where("quote_currency = ?", strings.ToUpper(*quoteCurrency))
+	}
+	if startDate != nil {
+		query = query.Where("date >= ?", datatypes.Date(*startDate))
+	}
+	if endDate != nil {
+		query = query.Where("date <= ?", datatypes.Date(*endDate))
+	}
+
+	err := query.Order("date DESC, base_currency ASC, quote_currency ASC").Find(&rates).Error
+	return rates, err
+}
+
+func (s *ExchangeRateService) DeleteRate(rateID uuid.UUID) error {
+	result := database.DB.Unscoped().Where("id = ?", rateID).Delete(&models.ExchangeRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrExchangeRateNotFound
+	}
+	return nil
+}
+
+func (s *ExchangeRateService) ImportRates(r io.Reader) (*ExchangeRateImport, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxUploadSize {
+		return nil, ErrFileTooLarge
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, ErrFileEmpty
+	}
+
+	result := &ExchangeRateImport{Format: models.ExchangeRateSourceCSV}
+	var rates []*models.ExchangeRate
+	if data[0] == '<' {
+		result.Format = models.ExchangeRateSourceECB
+		rates, err = parseECBRates(data)
+	} else {
+		rates, err = parseCSVRates(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: no rates found", ErrInvalidExchangeRateFile)
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		for _, rate := range rates {
+			rate.Source = result.Format
+			created, err := upsertExchangeRate(tx, rate)
+			if err != nil {
+				return err
+			}
+			if created {
+				result.Imported++
+			} else {
+				result.Updated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *ExchangeRateService) ReportingCurrency(userID uuid.UUID) (string, error) {
+	var user models.User
+	if err := database.DB.Select("reporting_currency").Where("id = ?", userID).First(&user).Error; err != nil {
+		return "", err
+	}
+	if user.ReportingCurrency != "" {
+		return user.ReportingCurrency, nil
+	}
+
+	var profile models.CompanyProfile
+	err := database.DB.Select("reporting_currency").Where("user_id = ?", userID).First(&profile).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return profile.ReportingCurrency, nil
+}
+
+func upsertExchangeRate(tx *gorm.DB, rate *models.ExchangeRate) (bool, error) {
+	var existing models.ExchangeRate
+	err := tx.Where("base_currency = ? AND quote_currency = ? AND date = ?", rate.BaseCurrency, rate.QuoteCurrency, rate.Date).First(&existing).Error
+	if err == nil {
+		rate.ID = existing.ID
+		rate.CreatedAt = existing.CreatedAt
+		return false, tx.Model(&existing).Updates(map[string]interface{}{"rate": rate.Rate, "source": rate.Source}).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	return true, tx.Create(rate).Error
+}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+func parseECBRates(data []byte) ([]*models.ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExchangeRateFile, err)
+	}
+
+	var rates []*models.ExchangeRate
+	for _, day := range envelope.Days {
+		date, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid date %q", ErrInvalidExchangeRateFile, day.Time)
+		}
+		for _, entry := range day.Rates {
+			value, err := strconv.ParseFloat(entry.Rate, 64)
+			if err != nil || value <= 0 || len(entry.Currency) != 3 {
+				return nil, fmt.Errorf("%w: invalid rate for %q on %s", ErrInvalidExchangeRateFile, entry.Currency, day.Time)
+			}
+			rates = append(rates, &models.ExchangeRate{
+				BaseCurrency:  "EUR",
+				QuoteCurrency: strings.ToUpper(entry.Currency),
+				Date:          datatypes.Date(date),
+				Rate:          value,
+			})
+		}
+	}
+	return rates, nil
+}
+
+func parseCSVRates(data []byte) ([]*models.ExchangeRate, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExchangeRateFile, err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "base_currency", "quote_currency", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidExchangeRateFile, name)
+		}
+	}
+
+	var rates []*models.ExchangeRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExchangeRateFile, err)
+		}
+
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(record[columns["date"]]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid date", ErrInvalidExchangeRateFile, line)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[columns["rate"]]), 64)
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("%w: line %d: invalid rate", ErrInvalidExchangeRateFile, line)
+		}
+		base := strings.ToUpper(strings.TrimSpace(record[columns["base_currency"]]))
+		quote := strings.ToUpper(strings.TrimSpace(record[columns["quote_currency"]]))
+		if len(base) != 3 || len(quote) != 3 || base == quote {
+			return nil, fmt.Errorf("%w: line %d: invalid currency pair", ErrInvalidExchangeRateFile, line)
+		}
+
+		rates = append(rates, &models.ExchangeRate{
+			BaseCurrency:  base,
+			QuoteCurrency: quote,
+			Date:          datatypes.Date(date),
+			Rate:          value,
+		})
+	}
+	return rates, nil
+}
+
+type currencyPair struct {
+	Base  string
+	Quote string
+}
+
+type currencyConverter struct {
+	target     string
+	pairs      map[currencyPair]rateHistory
+	currencies []string
+}
+
+func loadCurrencyConverter(target string) (*currencyConverter, error) {
+	var rates []models.ExchangeRate
+	if err := database.DB.Order("date ASC").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	converter := &currencyConverter{
+		target: strings.ToUpper(target),
+		pairs:  make(map[currencyPair]rateHistory),
+	}
+	seen := make(map[string]bool)
+	for _, rate := range rates {
+		pair := currencyPair{Base: rate.BaseCurrency, Quote: rate.QuoteCurrency}
+		converter.pairs[pair] = append(converter.pairs[pair], datedRate{EffectiveFrom: time.Time(rate.Date), Rate: rate.Rate})
+		for _, currency := range []string{rate.BaseCurrency, rate.QuoteCurrency} {
+			if !seen[currency] {
+				seen[currency] = true
+				converter.currencies = append(converter.currencies, currency)
+			}
+		}
+	}
+	sort.Strings(converter.currencies)
+
+	return converter, nil
+}
+
+func (c *currencyConverter) convert(amount float64, currency string, date time.Time) (float64, bool) {
+	currency = strings.ToUpper(currency)
+	if amount == 0 || currency == c.target {
+		return amount, true
+	}
+
+	rate, found := c.lookup(currency, c.target, date)
+	if !found {
+		for _, pivot := range c.currencies {
+			if pivot == currency || pivot == c.target {
+				continue
+			}
+			first, ok := c.lookup(currency, pivot, date)
+			if !ok {
+				continue
+			}
+			if second, ok := c.lookup(pivot, c.target, date); ok {
+				rate, found = first*second, true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return 0, false
+	}
+	return amount * rate, true
+}
+
+func (c *currencyConverter) lookup(from, to string, date time.Time) (float64, bool) {
+	if rate, found := c.pairs[currencyPair{Base: from, Quote: to}].find(date); found {
+		return rate, true
+	}
+	if rate, found := c.pairs[currencyPair{Base: to, Quote: from}].find(date); found {
+		return 1 / rate, true
+	}
+	return 0, false
+}
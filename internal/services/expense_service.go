@@ -0,0 +1,224 @@
+package services
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/storage"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ExpenseService struct {
+	storage storage.Storage
+}
+
+func NewExpenseService() *ExpenseService {
+	return &ExpenseService{
+		storage: storage.NewDefaultStorage(),
+	}
+}
+
+var (
+	ErrExpenseNotFound        = errors.New("expense not found")
+	ErrExpenseLocked          = errors.New("expense is locked by an invoice")
+	ErrInvalidExpenseCategory = errors.New("invalid expense category")
+	ErrReceiptNotFound        = errors.New("receipt not found")
+)
+
+type ExpenseFilter struct {
+	ProjectID  *uuid.UUID
+	StartDate  *time.Time
+	EndDate    *time.Time
+	Category   *models.ExpenseCategory
+	IsBillable *bool
+}
+
+func (s *ExpenseService) CreateExpense(userID, projectID uuid.UUID, date time.Time, amount float64, currency string, category models.ExpenseCategory, description string, isBillable bool, markupPercent float64) (*models.Expense, error) {
+	if date.After(time.Now()) {
+		return nil, ErrDateInFuture
+	}
+
+	if !models.IsValidExpenseCategory(category) {
+		return nil, ErrInvalidExpenseCategory
+	}
+
+	var project models.Project
+	if err := database.DB.Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+		return nil, errors.New("project not found or access denied")
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = project.Currency
+	}
+
+	expense := &models.Expense{
+		ProjectID:     projectID,
+		UserID:        userID,
+		Date:          datatypes.Date(date),
+		Amount:        amount,
+		Currency:      currency,
+		Category:      category,
+		Description:   description,
+		IsBillable:    isBillable,
+		MarkupPercent: markupPercent,
+	}
+
+	if err := database.DB.Create(expense).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetExpense(userID, expense.ID)
+}
+
+func (s *ExpenseService) GetExpense(userID, expenseID uuid.UUID) (*models.Expense, error) {
+	var expense models.Expense
+	err := database.DB.Preload("Project.Client").Where("id = ? AND user_id = ?", expenseID, userID).First(&expense).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExpenseNotFound
+		}
+		return nil, err
+	}
+	return &expense, nil
+}
+
+func (s *ExpenseService) ListExpenses(userID uuid.UUID, filter ExpenseFilter, offset, limit int) ([]*models.Expense, int64, error) {
+	var expenses []*models.Expense
+	var total int64
+
+	query := database.DB.Model(&models.Expense{}).Preload("Project.Client").Where("user_id = ?", userID)
+
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+
+	if filter.StartDate != nil {
+		query = query.Where("date >= ?", datatypes.Date(*filter.StartDate))
+	}
+
+	if filter.EndDate != nil {
+		query = query.Where("date <= ?", datatypes.Date(*filter.EndDate))
+	}
+
+	if filter.Category != nil {
+		query = query.Where("category = ?", *filter.Category)
+	}
+
+	if filter.IsBillable != nil {
+		query = query.Where("is_billable = ?", *filter.IsBillable)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("date DESC, created_at DESC").Find(&expenses).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return expenses, total, nil
+}
+
+func (s *ExpenseService) UpdateExpense(userID, expenseID uuid.UUID, updates map[string]interface{}) (*models.Expense, error) {
+	expense, err := s.GetExpense(userID, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expense.IsLocked() {
+		return nil, ErrExpenseLocked
+	}
+
+	if category, ok := updates["category"].(string); ok {
+		expenseCategory := models.ExpenseCategory(category)
+		if !models.IsValidExpenseCategory(expenseCategory) {
+			return nil, ErrInvalidExpenseCategory
+		}
+		updates["category"] = expenseCategory
+	}
+
+	if currency, ok := updates["currency"].(string); ok {
+		updates["currency"] = strings.ToUpper(strings.TrimSpace(currency))
+	}
+
+	if err := database.DB.Model(expense).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetExpense(userID, expenseID)
+}
+
+func (s *ExpenseService) DeleteExpense(userID, expenseID uuid.UUID) error {
+	expense, err := s.GetExpense(userID, expenseID)
+	if err != nil {
+		return err
+	}
+
+	if expense.IsLocked() {
+		return ErrExpenseLocked
+	}
+
+	return database.DB.Delete(expense).Error
+}
+
+func (s *ExpenseService) UploadReceipt(userID, expenseID uuid.UUID, fileName string, size int64, r io.Reader) (*models.Expense, error) {
+	expense, err := s.GetExpense(userID, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousKey := expense.ReceiptStorageKey
+	storageKey := "receipts/" + expense.ID.String() + "/" + uuid.New().String()
+
+	file, err := saveUpload(s.storage, storageKey, fileName, size, r)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"receipt_file_name":    file.FileName,
+		"receipt_content_type": file.ContentType,
+		"receipt_size":         file.Size,
+		"receipt_checksum":     file.Checksum,
+		"receipt_storage_key":  storageKey,
+	}
+
+	if err := database.DB.Model(expense).Updates(updates).Error; err != nil {
+		s.storage.Delete(storageKey)
+		return nil, err
+	}
+
+	if previousKey != "" {
+		s.storage.Delete(previousKey)
+	}
+
+	return s.GetExpense(userID, expenseID)
+}
+
+func (s *ExpenseService) OpenReceipt(userID, expenseID uuid.UUID) (*models.Expense, io.ReadCloser, error) {
+	expense, err := s.GetExpense(userID, expenseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !expense.HasReceipt() {
+		return nil, nil, ErrReceiptNotFound
+	}
+
+	reader, err := s.storage.Open(expense.ReceiptStorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, nil, ErrReceiptNotFound
+		}
+		return nil, nil, err
+	}
+
+	return expense, reader, nil
+}
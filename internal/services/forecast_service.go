@@ -0,0 +1,295 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+)
+
+type ForecastService struct{}
+
+func NewForecastService() *ForecastService {
+	return &ForecastService{}
+}
+
+type ForecastWeekStatus string
+
+const (
+	ForecastWeekPast    ForecastWeekStatus = "past"
+	ForecastWeekCurrent ForecastWeekStatus = "current"
+	ForecastWeekFuture  ForecastWeekStatus = "future"
+)
+
+type ForecastAmounts struct {
+	AllocatedHours  float64
+	ForecastAmount  float64
+	ActualHours     float64
+	ActualAmount    float64
+	ProjectedHours  float64
+	ProjectedAmount float64
+}
+
+// Variance is measured on a billable basis: allocations plan billable time and
+// actuals count billable hours net of write-downs, so non-billable or
+// written-down work shows up as a shortfall against the plan.
+func (a *ForecastAmounts) VarianceHours() float64 {
+	return a.ActualHours - a.AllocatedHours
+}
+
+func (a *ForecastAmounts) VarianceAmount() float64 {
+	return a.ActualAmount - a.ForecastAmount
+}
+
+func (a *ForecastAmounts) add(other ForecastAmounts) {
+	a.AllocatedHours += other.AllocatedHours
+	a.ForecastAmount += other.ForecastAmount
+	a.ActualHours += other.ActualHours
+	a.ActualAmount += other.ActualAmount
+	a.ProjectedHours += other.ProjectedHours
+	a.ProjectedAmount += other.ProjectedAmount
+}
+
+type ForecastLine struct {
+	Project models.Project
+	ForecastAmounts
+}
+
+type ForecastTotal struct {
+	Currency string
+	ForecastAmounts
+}
+
+type ForecastWeek struct {
+	WeekStarting time.Time
+	Status       ForecastWeekStatus
+	Lines        []*ForecastLine
+	Totals       []*ForecastTotal
+	Converted    *ForecastAmounts
+}
+
+type RevenueForecast struct {
+	AsOf              time.Time
+	TimeZone          string
+	StartDate         time.Time
+	EndDate           time.Time
+	Weeks             []*ForecastWeek
+	Upcoming          []*ForecastTotal
+	Past              []*ForecastTotal
+	Converted         *ConvertedTotals
+	ConvertedUpcoming *ForecastAmounts
+	ConvertedPast     *ForecastAmounts
+}
+
+func (s *ForecastService) GetRevenueForecast(userID uuid.UUID, asOf *time.Time, weeks, pastWeeks int, currency string) (*RevenueForecast, error) {
+	location, err := userLocation(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if asOf != nil {
+		today = *asOf
+	}
+
+	currentWeek := startOfWeek(today)
+	forecast := &RevenueForecast{
+		AsOf:      today,
+		TimeZone:  location.String(),
+		StartDate: currentWeek.AddDate(0, 0, -7*pastWeeks),
+		EndDate:   currentWeek.AddDate(0, 0, 7*weeks-1),
+	}
+
+	converter, err := NewReportService().loadConverter(userID, currency)
+	if err != nil {
+		return nil, err
+	}
+	if converter != nil {
+		forecast.Converted = &ConvertedTotals{Currency: converter.target}
+		forecast.ConvertedUpcoming = &ForecastAmounts{}
+		forecast.ConvertedPast = &ForecastAmounts{}
+	}
+
+	var allocations []*models.Allocation
+	err = database.DB.Joins("JOIN projects ON projects.id = allocations.project_id").
+		Where("projects.user_id = ?", userID).
+		Where("allocations.week_starting >= ? AND allocations.week_starting <= ?", forecast.StartDate, forecast.EndDate).
+		Find(&allocations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	weekColumn, _ := NewRevenueService().periodColumn(RevenuePeriodWeek)
+	actuals, err := NewRevenueService().aggregate(userID, forecast.StartDate, today.AddDate(0, 0, -1), []string{"time_entries.project_id"}, weekColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs := make(map[uuid.UUID]bool)
+	for _, allocation := range allocations {
+		projectIDs[allocation.ProjectID] = true
+	}
+	for _, actual := range actuals {
+		projectIDs[actual.ProjectID] = true
+	}
+	ids := make([]uuid.UUID, 0, len(projectIDs))
+	for id := range projectIDs {
+		ids = append(ids, id)
+	}
+
+	var projects []models.Project
+	if len(ids) > 0 {
+		if err := database.DB.Unscoped().Preload("Client").Where("id IN ?", ids).Find(&projects).Error; err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[uuid.UUID]models.Project, len(projects))
+	for _, project := range projects {
+		byID[project.ID] = project
+	}
+
+	rates, err := loadRateResolver(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	type lineKey struct {
+		Week      string
+		ProjectID uuid.UUID
+	}
+
+	lines := make(map[lineKey]*ForecastLine)
+	lineFor := func(week string, projectID uuid.UUID) *ForecastLine {
+		key := lineKey{Week: week, ProjectID: projectID}
+		if _, exists := lines[key]; !exists {
+			lines[key] = &ForecastLine{Project: byID[projectID]}
+		}
+		return lines[key]
+	}
+
+	for _, allocation := range allocations {
+		week := startOfWeek(allocation.WeekStarting.UTC())
+		project := byID[allocation.ProjectID]
+		line := lineFor(week.Format("2006-01-02"), allocation.ProjectID)
+		line.AllocatedHours += allocation.Hours
+		line.ForecastAmount += allocation.Hours * rates.rate(&project, allocation.UserID, week)
+	}
+	for _, actual := range actuals {
+		line := lineFor(actual.PeriodStart, actual.ProjectID)
+		line.ActualHours += actual.Hours
+		line.ActualAmount += actual.Amount
+	}
+
+	remaining := s.remainingWorkdays(today) / 5
+	for week := forecast.StartDate; !week.After(forecast.EndDate); week = week.AddDate(0, 0, 7) {
+		forecastWeek := &ForecastWeek{WeekStarting: week, Status: ForecastWeekFuture}
+		switch {
+		case week.Before(currentWeek):
+			forecastWeek.Status = ForecastWeekPast
+		case week.Equal(currentWeek):
+			forecastWeek.Status = ForecastWeekCurrent
+		}
+		if converter != nil {
+			forecastWeek.Converted = &ForecastAmounts{}
+		}
+
+		totals := make(map[string]*ForecastTotal)
+		key := week.Format("2006-01-02")
+		for lineID, line := range lines {
+			if lineID.Week != key {
+				continue
+			}
+
+			switch forecastWeek.Status {
+			case ForecastWeekPast:
+				line.ProjectedHours = line.ActualHours
+				line.ProjectedAmount = line.ActualAmount
+			case ForecastWeekCurrent:
+				line.ProjectedHours = line.ActualHours + line.AllocatedHours*remaining
+				line.ProjectedAmount = line.ActualAmount + line.ForecastAmount*remaining
+			default:
+				line.ProjectedHours = line.AllocatedHours
+				line.ProjectedAmount = line.ForecastAmount
+			}
+			forecastWeek.Lines = append(forecastWeek.Lines, line)
+
+			total, exists := totals[line.Project.Currency]
+			if !exists {
+				total = &ForecastTotal{Currency: line.Project.Currency}
+				totals[line.Project.Currency] = total
+				forecastWeek.Totals = append(forecastWeek.Totals, total)
+			}
+			total.add(line.ForecastAmounts)
+
+			if converter != nil {
+				forecastWeek.Converted.add(ForecastAmounts{
+					AllocatedHours:  line.AllocatedHours,
+					ForecastAmount:  forecast.Converted.convert(converter, line.ForecastAmount, line.Project.Currency, week),
+					ActualHours:     line.ActualHours,
+					ActualAmount:    forecast.Converted.convert(converter, line.ActualAmount, line.Project.Currency, week),
+					ProjectedHours:  line.ProjectedHours,
+					ProjectedAmount: forecast.Converted.convert(converter, line.ProjectedAmount, line.Project.Currency, week),
+				})
+			}
+		}
+
+		sort.Slice(forecastWeek.Lines, func(i, j int) bool {
+			return forecastWeek.Lines[i].Project.Name < forecastWeek.Lines[j].Project.Name
+		})
+		sort.Slice(forecastWeek.Totals, func(i, j int) bool {
+			return forecastWeek.Totals[i].Currency < forecastWeek.Totals[j].Currency
+		})
+
+		if forecastWeek.Status == ForecastWeekPast {
+			forecast.Past = mergeForecastTotals(forecast.Past, forecastWeek.Totals)
+			if converter != nil {
+				forecast.ConvertedPast.add(*forecastWeek.Converted)
+			}
+		} else {
+			forecast.Upcoming = mergeForecastTotals(forecast.Upcoming, forecastWeek.Totals)
+			if converter != nil {
+				forecast.ConvertedUpcoming.add(*forecastWeek.Converted)
+			}
+		}
+		forecast.Weeks = append(forecast.Weeks, forecastWeek)
+	}
+
+	return forecast, nil
+}
+
+// remainingWorkdays counts today as still to come; actuals stop at yesterday so
+// the current day is never both logged and projected.
+func (s *ForecastService) remainingWorkdays(today time.Time) float64 {
+	var days float64
+	for day := today; !day.After(startOfWeek(today).AddDate(0, 0, 6)); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	return days
+}
+
+func mergeForecastTotals(totals []*ForecastTotal, additions []*ForecastTotal) []*ForecastTotal {
+	for _, addition := range additions {
+		var target *ForecastTotal
+		for _, total := range totals {
+			if total.Currency == addition.Currency {
+				target = total
+				break
+			}
+		}
+
+		if target == nil {
+			target = &ForecastTotal{Currency: addition.Currency}
+			totals = append(totals, target)
+		}
+		target.add(addition.ForecastAmounts)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Currency < totals[j].Currency
+	})
+	return totals
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type HolidayService struct{}
+
+func NewHolidayService() *HolidayService {
+	return &HolidayService{}
+}
+
+var (
+	ErrHolidayNotFound = errors.New("holiday not found")
+	ErrHolidayExists   = errors.New("holiday already exists for this date")
+)
+
+func (s *HolidayService) CreateHoliday(date time.Time, name string) (*models.Holiday, error) {
+	var existing models.Holiday
+	if err := database.DB.Where("date = ?", datatypes.Date(date)).First(&existing).Error; err == nil {
+		return nil, ErrHolidayExists
+	}
+
+	holiday := &models.Holiday{
+		Date: datatypes.Date(date),
+		Name: name,
+	}
+
+	if err := database.DB.Create(holiday).Error; err != nil {
+		return nil, err
+	}
+
+	return holiday, nil
+}
+
+func (s *HolidayService) ListHolidays(startDate, endDate *time.Time) ([]*models.Holiday, error) {
+	var holidays []*models.Holiday
+
+	query := database.DB.Model(&models.Holiday{})
+
+	if startDate != nil {
+		query = query.Where("date >= ?", datatypes.Date(*startDate))
+	}
+
+	if endDate != nil {
+		query = query.Where("date <= ?", datatypes.Date(*endDate))
+	}
+
+	err := query.Order("date ASC").Find(&holidays).Error
+	return holidays, err
+}
+
+func (s *HolidayService) DeleteHoliday(holidayID uuid.UUID) error {
+	result := database.DB.Where("id = ?", holidayID).Delete(&models.Holiday{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrHolidayNotFound
+	}
+	return nil
+}
+
+func (s *HolidayService) HolidayDates(startDate, endDate time.Time) (map[string]string, error) {
+	holidays, err := s.ListHolidays(&startDate, &endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[string]string, len(holidays))
+	for _, holiday := range holidays {
+		dates[time.Time(holiday.Date).Format("2006-01-02")] = holiday.Name
+	}
+	return dates, nil
+}
@@ -0,0 +1,570 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type InvoiceService struct{}
+
+func NewInvoiceService() *InvoiceService {
+	return &InvoiceService{}
+}
+
+var (
+	ErrInvoiceNotFound          = errors.New("invoice not found")
+	ErrNothingToInvoice         = errors.New("no uninvoiced billable time or expenses for this client and period")
+	ErrInvoiceMixedCurrencies   = errors.New("billable items span several currencies, specify one")
+	ErrInvalidInvoiceTransition = errors.New("invoice status does not allow this action")
+	ErrInvalidInvoiceGrouping   = errors.New("invalid invoice grouping")
+)
+
+const defaultPaymentTermDays = 30
+
+type invoiceItem struct {
+	ID          uuid.UUID
+	Type        models.InvoiceLineType
+	Project     models.Project
+	Description string
+	Currency    string
+	Quantity    float64
+	Unit        string
+	Rate        float64
+	Amount      float64
+}
+
+type InvoiceFilter struct {
+	ClientID *uuid.UUID
+	Status   *models.InvoiceStatus
+}
+
+func (s *InvoiceService) CreateInvoice(userID, clientID uuid.UUID, startDate, endDate time.Time, grouping models.InvoiceGrouping, currency, notes string) (*models.Invoice, error) {
+	if grouping != models.InvoiceGroupingProject && grouping != models.InvoiceGroupingActivity {
+		return nil, ErrInvalidInvoiceGrouping
+	}
+
+	var client models.Client
+	if err := database.DB.Where("id = ? AND user_id = ?", clientID, userID).First(&client).Error; err != nil {
+		return nil, ErrClientNotFound
+	}
+
+	var entries []*models.TimeEntry
+	err := database.DB.Preload("Project").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Where("projects.user_id = ? AND projects.client_id = ? AND projects.deleted_at IS NULL", userID, clientID).
+		Where("time_entries.is_billable = ? AND time_entries.invoice_id IS NULL", true).
+		Where("time_entries.date >= ? AND time_entries.date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+		Order("time_entries.date ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.loadBillableItems(userID, clientID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	currency = strings.ToUpper(currency)
+	var selected []*models.TimeEntry
+	var selectedItems []*invoiceItem
+	currencies := make(map[string]bool)
+	for _, entry := range entries {
+		if currency != "" && entry.Project.Currency != currency {
+			continue
+		}
+		currencies[entry.Project.Currency] = true
+		selected = append(selected, entry)
+	}
+	for _, item := range items {
+		if currency != "" && item.Currency != currency {
+			continue
+		}
+		currencies[item.Currency] = true
+		selectedItems = append(selectedItems, item)
+	}
+
+	if len(selected) == 0 && len(selectedItems) == 0 {
+		return nil, ErrNothingToInvoice
+	}
+
+	if len(currencies) > 1 {
+		return nil, ErrInvoiceMixedCurrencies
+	}
+
+	invoice := &models.Invoice{
+		ClientID:    clientID,
+		UserID:      userID,
+		Status:      models.InvoiceStatusDraft,
+		Grouping:    grouping,
+		PeriodStart: datatypes.Date(startDate),
+		PeriodEnd:   datatypes.Date(endDate),
+		Notes:       notes,
+	}
+	for code := range currencies {
+		invoice.Currency = code
+	}
+
+	lines, entryLines, err := s.buildLines(selected, grouping)
+	if err != nil {
+		return nil, err
+	}
+	itemLines, lineByItem := s.buildItemLines(selectedItems)
+	lines = append(lines, itemLines...)
+	for i, line := range lines {
+		line.Position = i + 1
+		invoice.TotalHours += line.Hours
+		invoice.Subtotal += line.Amount
+	}
+	invoice.Total = invoice.Subtotal
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(invoice).Error; err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			line.InvoiceID = invoice.ID
+			if err := tx.Create(line).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := NewTaxService().applyInvoiceTax(tx, invoice, &client, time.Now()); err != nil {
+			return err
+		}
+
+		for _, entry := range selected {
+			result := tx.Model(&models.TimeEntry{}).
+				Where("id = ? AND invoice_id IS NULL", entry.ID).
+				Updates(map[string]interface{}{
+					"invoice_id":      invoice.ID,
+					"invoice_line_id": entryLines[entry.ID].ID,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrNothingToInvoice
+			}
+		}
+
+		for _, item := range selectedItems {
+			result := tx.Model(invoiceItemModel(item.Type)).
+				Where("id = ? AND invoice_id IS NULL", item.ID).
+				Updates(map[string]interface{}{
+					"invoice_id":      invoice.ID,
+					"invoice_line_id": lineByItem[item.ID].ID,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrNothingToInvoice
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(userID, invoice.ID)
+}
+
+func (s *InvoiceService) buildLines(entries []*models.TimeEntry, grouping models.InvoiceGrouping) ([]*models.InvoiceLine, map[uuid.UUID]*models.InvoiceLine, error) {
+	type lineKey struct {
+		ProjectID uuid.UUID
+		Activity  string
+		Rate      float64
+	}
+
+	projectIDs := make([]uuid.UUID, len(entries))
+	for i, entry := range entries {
+		projectIDs[i] = entry.ProjectID
+	}
+	rates, err := loadRateResolver(projectIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lines []*models.InvoiceLine
+	byKey := make(map[lineKey]*models.InvoiceLine)
+	entryLines := make(map[uuid.UUID]*models.InvoiceLine)
+
+	for _, entry := range entries {
+		key := lineKey{
+			ProjectID: entry.ProjectID,
+			Rate:      rates.rate(&entry.Project, entry.UserID, time.Time(entry.Date)),
+		}
+		description := entry.Project.Name
+		if grouping == models.InvoiceGroupingActivity {
+			key.Activity = strings.ToLower(strings.TrimSpace(entry.Description))
+			description = entry.Project.Name + " - " + strings.TrimSpace(entry.Description)
+		}
+
+		line, exists := byKey[key]
+		if !exists {
+			line = &models.InvoiceLine{
+				ProjectID:   entry.ProjectID,
+				Description: description,
+				Rate:        key.Rate,
+			}
+			line.ID = uuid.New()
+			byKey[key] = line
+			lines = append(lines, line)
+		}
+
+		line.Hours += entry.BillableHours()
+		line.WrittenDownHours += entry.WrittenDownHours
+		line.Amount = roundMoney(line.Hours * line.Rate)
+		entryLines[entry.ID] = line
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].Description != lines[j].Description {
+			return lines[i].Description < lines[j].Description
+		}
+		return lines[i].Rate < lines[j].Rate
+	})
+
+	return lines, entryLines, nil
+}
+
+func (s *InvoiceService) loadBillableItems(userID, clientID uuid.UUID, startDate, endDate time.Time) ([]*invoiceItem, error) {
+	scope := func(table string) func(db *gorm.DB) *gorm.DB {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Preload("Project").
+				Joins("JOIN projects ON projects.id = "+table+".project_id").
+				Where(table+".user_id = ? AND projects.client_id = ? AND projects.deleted_at IS NULL", userID, clientID).
+				Where(table+".is_billable = ? AND "+table+".invoice_id IS NULL", true).
+				Where(table+".date >= ? AND "+table+".date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+				Order(table + ".date ASC")
+		}
+	}
+
+	var expenses []*models.Expense
+	if err := database.DB.Scopes(scope("expenses")).Find(&expenses).Error; err != nil {
+		return nil, err
+	}
+	var mileage []*models.MileageEntry
+	if err := database.DB.Scopes(scope("mileage_entries")).Preload("Rate").Find(&mileage).Error; err != nil {
+		return nil, err
+	}
+	var perDiems []*models.PerDiemClaim
+	if err := database.DB.Scopes(scope("per_diem_claims")).Find(&perDiems).Error; err != nil {
+		return nil, err
+	}
+
+	var items []*invoiceItem
+	for _, expense := range expenses {
+		items = append(items, &invoiceItem{
+			ID:          expense.ID,
+			Type:        models.InvoiceLineTypeExpense,
+			Project:     expense.Project,
+			Description: expense.Project.Name + " - Expenses (" + strings.ReplaceAll(string(expense.Category), "_", " ") + ")",
+			Currency:    expense.Currency,
+			Quantity:    1,
+			Unit:        "item",
+			Amount:      expense.BillableAmount(),
+		})
+	}
+	for _, entry := range mileage {
+		items = append(items, &invoiceItem{
+			ID:          entry.ID,
+			Type:        models.InvoiceLineTypeMileage,
+			Project:     entry.Project,
+			Description: entry.Project.Name + " - Mileage (" + entry.Rate.DistanceUnit + ")",
+			Currency:    entry.Currency,
+			Quantity:    entry.Distance,
+			Unit:        entry.Rate.DistanceUnit,
+			Rate:        entry.RatePerUnit,
+			Amount:      entry.Amount,
+		})
+	}
+	for _, claim := range perDiems {
+		items = append(items, &invoiceItem{
+			ID:          claim.ID,
+			Type:        models.InvoiceLineTypePerDiem,
+			Project:     claim.Project,
+			Description: claim.Project.Name + " - Per diem (" + claim.Country + ")",
+			Currency:    claim.Currency,
+			Quantity:    claim.Days,
+			Unit:        "day",
+			Rate:        claim.DailyRate,
+			Amount:      claim.Amount,
+		})
+	}
+
+	return items, nil
+}
+
+func (s *InvoiceService) buildItemLines(items []*invoiceItem) ([]*models.InvoiceLine, map[uuid.UUID]*models.InvoiceLine) {
+	type lineKey struct {
+		ProjectID   uuid.UUID
+		Type        models.InvoiceLineType
+		Description string
+		Rate        float64
+	}
+
+	var lines []*models.InvoiceLine
+	byKey := make(map[lineKey]*models.InvoiceLine)
+	itemLines := make(map[uuid.UUID]*models.InvoiceLine)
+
+	for _, item := range items {
+		key := lineKey{
+			ProjectID:   item.Project.ID,
+			Type:        item.Type,
+			Description: item.Description,
+			Rate:        item.Rate,
+		}
+
+		line, exists := byKey[key]
+		if !exists {
+			line = &models.InvoiceLine{
+				ProjectID:   item.Project.ID,
+				Type:        item.Type,
+				Description: item.Description,
+				Unit:        item.Unit,
+				Rate:        item.Rate,
+			}
+			line.ID = uuid.New()
+			byKey[key] = line
+			lines = append(lines, line)
+		}
+
+		line.Quantity += item.Quantity
+		line.Amount += item.Amount
+		itemLines[item.ID] = line
+	}
+
+	for _, line := range lines {
+		if line.Type == models.InvoiceLineTypeExpense {
+			line.Quantity = 1
+			line.Rate = line.Amount
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].Type != lines[j].Type {
+			return lines[i].Type < lines[j].Type
+		}
+		if lines[i].Description != lines[j].Description {
+			return lines[i].Description < lines[j].Description
+		}
+		return lines[i].Rate < lines[j].Rate
+	})
+
+	return lines, itemLines
+}
+
+func invoiceItemModel(lineType models.InvoiceLineType) interface{} {
+	switch lineType {
+	case models.InvoiceLineTypeExpense:
+		return &models.Expense{}
+	case models.InvoiceLineTypeMileage:
+		return &models.MileageEntry{}
+	case models.InvoiceLineTypePerDiem:
+		return &models.PerDiemClaim{}
+	}
+	return &models.TimeEntry{}
+}
+
+func (s *InvoiceService) GetInvoice(userID, invoiceID uuid.UUID) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := database.DB.Preload("Client").
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Lines.Project").
+		Preload("TaxLines").
+		Where("id = ? AND user_id = ?", invoiceID, userID).
+		First(&invoice).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+func (s *InvoiceService) ListInvoices(userID uuid.UUID, filter InvoiceFilter, offset, limit int) ([]*models.Invoice, int64, error) {
+	var invoices []*models.Invoice
+	var total int64
+
+	query := database.DB.Model(&models.Invoice{}).Preload("Client").Where("user_id = ?", userID)
+
+	if filter.ClientID != nil {
+		query = query.Where("client_id = ?", *filter.ClientID)
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&invoices).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return invoices, total, nil
+}
+
+func (s *InvoiceService) IssueInvoice(userID, invoiceID uuid.UUID, issueDate time.Time, paymentTermDays int) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status != models.InvoiceStatusDraft {
+		return nil, ErrInvalidInvoiceTransition
+	}
+
+	if paymentTermDays <= 0 {
+		paymentTermDays = defaultPaymentTermDays
+	}
+
+	issued := datatypes.Date(issueDate)
+	due := datatypes.Date(issueDate.AddDate(0, 0, paymentTermDays))
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		value, err := nextSequenceValue(tx, userID, SequenceInvoice)
+		if err != nil {
+			return err
+		}
+
+		if err := NewTaxService().applyInvoiceTax(tx, invoice, &invoice.Client, issueDate); err != nil {
+			return err
+		}
+
+		return tx.Model(invoice).Updates(map[string]interface{}{
+			"number":     formatSequenceNumber("INV", value),
+			"status":     models.InvoiceStatusIssued,
+			"issue_date": issued,
+			"due_date":   due,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(userID, invoiceID)
+}
+
+func (s *InvoiceService) MarkInvoicePaid(userID, invoiceID uuid.UUID, paidAt time.Time) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status != models.InvoiceStatusIssued {
+		return nil, ErrInvalidInvoiceTransition
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		_, err := recordPayment(tx, invoice, PaymentInput{
+			Amount: invoice.BalanceDue(),
+			Date:   paidAt,
+			Method: models.PaymentMethodOther,
+			Notes:  "Marked as paid",
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(userID, invoiceID)
+}
+
+func (s *InvoiceService) VoidInvoice(userID, invoiceID uuid.UUID) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Status != models.InvoiceStatusDraft && invoice.Status != models.InvoiceStatusIssued {
+		return nil, ErrInvalidInvoiceTransition
+	}
+	if invoice.AmountPaid > 0 {
+		return nil, ErrInvoiceHasPayments
+	}
+	if invoice.AmountCredited > 0 {
+		return nil, ErrInvoiceHasCreditNotes
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(invoice).Updates(map[string]interface{}{
+			"status":    models.InvoiceStatusVoid,
+			"voided_at": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+		return s.releaseItems(tx, invoice.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(userID, invoiceID)
+}
+
+func (s *InvoiceService) DeleteInvoice(userID, invoiceID uuid.UUID) error {
+	invoice, err := s.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	if !invoice.IsEditable() {
+		return ErrInvalidInvoiceTransition
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.releaseItems(tx, invoice.ID); err != nil {
+			return err
+		}
+		if err := tx.Where("invoice_id = ?", invoice.ID).Delete(&models.InvoiceLine{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("invoice_id = ?", invoice.ID).Delete(&models.InvoiceTaxLine{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("invoice_id = ?", invoice.ID).Delete(&models.WriteDown{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(invoice).Error
+	})
+}
+
+func (s *InvoiceService) releaseItems(tx *gorm.DB, invoiceID uuid.UUID) error {
+	for _, lineType := range []models.InvoiceLineType{
+		models.InvoiceLineTypeTime,
+		models.InvoiceLineTypeExpense,
+		models.InvoiceLineTypeMileage,
+		models.InvoiceLineTypePerDiem,
+	} {
+		err := tx.Unscoped().Model(invoiceItemModel(lineType)).
+			Where("invoice_id = ?", invoiceID).
+			Updates(map[string]interface{}{
+				"invoice_id":      nil,
+				"invoice_line_id": nil,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type LeaveService struct{}
+
+func NewLeaveService() *LeaveService {
+	return &LeaveService{}
+}
+
+var (
+	ErrLeaveNotFound     = errors.New("leave not found")
+	ErrLeaveOverlap      = errors.New("leave already recorded for part of this period")
+	ErrInvalidLeaveRange = errors.New("end_date must not be before start_date")
+)
+
+func (s *LeaveService) CreateLeave(userID uuid.UUID, startDate, endDate time.Time, leaveType models.LeaveType, notes string) (*models.Leave, error) {
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidLeaveRange
+	}
+
+	var count int64
+	if err := database.DB.Model(&models.Leave{}).
+		Where("user_id = ? AND start_date <= ? AND end_date >= ?", userID, datatypes.Date(endDate), datatypes.Date(startDate)).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrLeaveOverlap
+	}
+
+	leave := &models.Leave{
+		UserID:    userID,
+		StartDate: datatypes.Date(startDate),
+		EndDate:   datatypes.Date(endDate),
+		Type:      leaveType,
+		Notes:     notes,
+	}
+
+	if err := database.DB.Create(leave).Error; err != nil {
+		return nil, err
+	}
+
+	return leave, nil
+}
+
+func (s *LeaveService) ListLeave(userID uuid.UUID, startDate, endDate *time.Time) ([]*models.Leave, error) {
+	var leave []*models.Leave
+
+	query := database.DB.Model(&models.Leave{}).Where("user_id = ?", userID)
+
+	if startDate != nil {
+		query = query.Where("end_date >= ?", datatypes.Date(*startDate))
+	}
+
+	if endDate != nil {
+		query = query.Where("start_date <= ?", datatypes.Date(*endDate))
+	}
+
+	err := query.Order("start_date ASC").Find(&leave).Error
+	return leave, err
+}
+
+func (s *LeaveService) DeleteLeave(userID, leaveID uuid.UUID) error {
+	result := database.DB.Where("id = ? AND user_id = ?", leaveID, userID).Delete(&models.Leave{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLeaveNotFound
+	}
+	return nil
+}
+
+func (s *LeaveService) LeaveDates(userIDs []uuid.UUID, startDate, endDate time.Time) (map[uuid.UUID]map[string]models.LeaveType, error) {
+	var leave []*models.Leave
+	err := database.DB.Where("user_id IN ? AND start_date <= ? AND end_date >= ?", userIDs, datatypes.Date(endDate), datatypes.Date(startDate)).
+		Find(&leave).Error
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[uuid.UUID]map[string]models.LeaveType)
+	for _, period := range leave {
+		if dates[period.UserID] == nil {
+			dates[period.UserID] = make(map[string]models.LeaveType)
+		}
+		for day := time.Time(period.StartDate); !day.After(time.Time(period.EndDate)); day = day.AddDate(0, 0, 1) {
+			if day.Before(startDate) || day.After(endDate) {
+				continue
+			}
+			dates[period.UserID][day.Format("2006-01-02")] = period.Type
+		}
+	}
+	return dates, nil
+}
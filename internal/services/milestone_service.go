@@ -0,0 +1,92 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MilestoneService struct {
+	projectService *ProjectService
+}
+
+func NewMilestoneService() *MilestoneService {
+	return &MilestoneService{
+		projectService: NewProjectService(),
+	}
+}
+
+var (
+	ErrMilestoneNotFound      = errors.New("milestone not found")
+	ErrMilestonesNeedFixedFee = errors.New("milestones can only be added to fixed fee projects")
+)
+
+func (s *MilestoneService) CreateMilestone(userID, projectID uuid.UUID, milestone *models.ProjectMilestone) (*models.ProjectMilestone, error) {
+	project, err := s.projectService.GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.BillingType != models.BillingTypeFixedFee {
+		return nil, ErrMilestonesNeedFixedFee
+	}
+
+	milestone.ProjectID = projectID
+	if err := database.DB.Create(milestone).Error; err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+func (s *MilestoneService) ListMilestones(userID, projectID uuid.UUID) ([]*models.ProjectMilestone, error) {
+	if _, err := s.projectService.GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+	return listMilestones(projectID)
+}
+
+func (s *MilestoneService) UpdateMilestone(userID, projectID, milestoneID uuid.UUID, updates map[string]interface{}) (*models.ProjectMilestone, error) {
+	milestone, err := s.getMilestone(userID, projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Model(milestone).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.getMilestone(userID, projectID, milestoneID)
+}
+
+func (s *MilestoneService) DeleteMilestone(userID, projectID, milestoneID uuid.UUID) error {
+	milestone, err := s.getMilestone(userID, projectID, milestoneID)
+	if err != nil {
+		return err
+	}
+	return database.DB.Delete(milestone).Error
+}
+
+func (s *MilestoneService) getMilestone(userID, projectID, milestoneID uuid.UUID) (*models.ProjectMilestone, error) {
+	if _, err := s.projectService.GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	var milestone models.ProjectMilestone
+	if err := database.DB.Where("id = ? AND project_id = ?", milestoneID, projectID).First(&milestone).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMilestoneNotFound
+		}
+		return nil, err
+	}
+	return &milestone, nil
+}
+
+func listMilestones(projectID uuid.UUID) ([]*models.ProjectMilestone, error) {
+	var milestones []*models.ProjectMilestone
+	err := database.DB.Where("project_id = ?", projectID).
+		Order("due_date IS NULL, due_date ASC, created_at ASC").
+		Find(&milestones).Error
+	return milestones, err
+}
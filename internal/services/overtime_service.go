@@ -0,0 +1,257 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type OvertimeService struct{}
+
+func NewOvertimeService() *OvertimeService {
+	return &OvertimeService{}
+}
+
+var (
+	ErrOvertimeRuleNotFound = errors.New("overtime rule not found")
+	ErrOvertimeRuleExists   = errors.New("an overtime rule already exists for this client")
+)
+
+type OvertimeSplit struct {
+	RegularHours  float64
+	OvertimeHours float64
+	WeekendHours  float64
+	HolidayHours  float64
+}
+
+func (s *OvertimeSplit) Add(other OvertimeSplit) {
+	s.RegularHours += other.RegularHours
+	s.OvertimeHours += other.OvertimeHours
+	s.WeekendHours += other.WeekendHours
+	s.HolidayHours += other.HolidayHours
+}
+
+func (s OvertimeSplit) TotalHours() float64 {
+	return s.RegularHours + s.OvertimeHours + s.WeekendHours + s.HolidayHours
+}
+
+func (s OvertimeSplit) PremiumHours(rule models.OvertimeRule) float64 {
+	return s.OvertimeHours*(rule.OvertimeMultiplier-1) +
+		s.WeekendHours*(rule.WeekendMultiplier-1) +
+		s.HolidayHours*(rule.HolidayMultiplier-1)
+}
+
+type EntryOvertimeSplit struct {
+	OvertimeSplit
+	TimeEntry *models.TimeEntry
+}
+
+type OvertimeResult struct {
+	Rule      models.OvertimeRule
+	StartDate time.Time
+	EndDate   time.Time
+	Totals    OvertimeSplit
+	Entries   []EntryOvertimeSplit
+}
+
+func (r *OvertimeResult) ByProject() map[uuid.UUID]*OvertimeSplit {
+	projects := make(map[uuid.UUID]*OvertimeSplit)
+	for _, entry := range r.Entries {
+		split, exists := projects[entry.TimeEntry.ProjectID]
+		if !exists {
+			split = &OvertimeSplit{}
+			projects[entry.TimeEntry.ProjectID] = split
+		}
+		split.Add(entry.OvertimeSplit)
+	}
+	return projects
+}
+
+func (s *OvertimeService) CreateRule(userID uuid.UUID, clientID *uuid.UUID, rule models.OvertimeRule) (*models.OvertimeRule, error) {
+	if clientID != nil {
+		var client models.Client
+		if err := database.DB.Where("id = ? AND user_id = ?", *clientID, userID).First(&client).Error; err != nil {
+			return nil, errors.New("client not found or access denied")
+		}
+	}
+
+	if _, err := s.findRule(userID, clientID); err == nil {
+		return nil, ErrOvertimeRuleExists
+	}
+
+	rule.ID = uuid.Nil
+	rule.UserID = userID
+	rule.ClientID = clientID
+	rule.IsActive = true
+
+	if err := database.DB.Create(&rule).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetRule(userID, rule.ID)
+}
+
+func (s *OvertimeService) GetRule(userID, ruleID uuid.UUID) (*models.OvertimeRule, error) {
+	var rule models.OvertimeRule
+	err := database.DB.Preload("Client").Where("id = ? AND user_id = ?", ruleID, userID).First(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOvertimeRuleNotFound
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (s *OvertimeService) ListRules(userID uuid.UUID) ([]*models.OvertimeRule, error) {
+	var rules []*models.OvertimeRule
+	err := database.DB.Preload("Client").Where("user_id = ?", userID).
+		Order("client_id IS NOT NULL, name ASC").
+		Find(&rules).Error
+	return rules, err
+}
+
+func (s *OvertimeService) UpdateRule(userID, ruleID uuid.UUID, updates map[string]interface{}) (*models.OvertimeRule, error) {
+	rule, err := s.GetRule(userID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Model(rule).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetRule(userID, ruleID)
+}
+
+func (s *OvertimeService) DeleteRule(userID, ruleID uuid.UUID) error {
+	result := database.DB.Where("id = ? AND user_id = ?", ruleID, userID).Delete(&models.OvertimeRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrOvertimeRuleNotFound
+	}
+	return nil
+}
+
+func (s *OvertimeService) GetEffectiveRule(userID uuid.UUID) models.OvertimeRule {
+	rule, err := s.findRule(userID, nil)
+	if err != nil || !rule.IsActive {
+		return models.DefaultOvertimeRule()
+	}
+	return *rule
+}
+
+func (s *OvertimeService) GetBillingRule(userID, clientID uuid.UUID) models.OvertimeRule {
+	rule, err := s.findRule(userID, &clientID)
+	if err != nil || !rule.IsActive {
+		return models.FlatRateRule()
+	}
+	return *rule
+}
+
+func (s *OvertimeService) CalculateOvertime(userID uuid.UUID, startDate, endDate time.Time) (*OvertimeResult, error) {
+	rule := s.GetEffectiveRule(userID)
+
+	entries, holidays, err := s.loadWeeks(userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &OvertimeResult{
+		Rule:      rule,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	splits := splitOvertime(rule, entries, holidays, func(entry *models.TimeEntry) float64 {
+		return entry.Hours
+	})
+	for i, entry := range entries {
+		date := time.Time(entry.Date)
+		if date.Before(startDate) || date.After(endDate) {
+			continue
+		}
+
+		result.Entries = append(result.Entries, EntryOvertimeSplit{OvertimeSplit: splits[i], TimeEntry: entry})
+		result.Totals.Add(splits[i])
+	}
+
+	return result, nil
+}
+
+func (s *OvertimeService) loadWeeks(userID uuid.UUID, startDate, endDate time.Time) ([]*models.TimeEntry, map[string]string, error) {
+	rangeStart := startOfWeek(startDate)
+	rangeEnd := startOfWeek(endDate).AddDate(0, 0, 6)
+
+	var entries []*models.TimeEntry
+	err := database.DB.Preload("Project.Client").
+		Where("user_id = ? AND date >= ? AND date <= ?", userID, datatypes.Date(rangeStart), datatypes.Date(rangeEnd)).
+		Order("date ASC, created_at ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	holidays, err := NewHolidayService().HolidayDates(rangeStart, rangeEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, holidays, nil
+}
+
+func splitOvertime(rule models.OvertimeRule, entries []*models.TimeEntry, holidays map[string]string, hoursOf func(*models.TimeEntry) float64) []OvertimeSplit {
+	splits := make([]OvertimeSplit, len(entries))
+	dailyRegular := make(map[string]float64)
+	weeklyRegular := make(map[string]float64)
+
+	for i, entry := range entries {
+		date := time.Time(entry.Date)
+		dateKey := date.Format("2006-01-02")
+		weekKey := startOfWeek(date).Format("2006-01-02")
+		hours := hoursOf(entry)
+
+		split := &splits[i]
+		switch {
+		case holidays[dateKey] != "":
+			split.HolidayHours = hours
+		case date.Weekday() == time.Saturday || date.Weekday() == time.Sunday:
+			split.WeekendHours = hours
+		default:
+			available := hours
+			if rule.DailyThreshold > 0 {
+				available = min(available, rule.DailyThreshold-dailyRegular[dateKey])
+			}
+			if rule.WeeklyThreshold > 0 {
+				available = min(available, rule.WeeklyThreshold-weeklyRegular[weekKey])
+			}
+			split.RegularHours = max(available, 0)
+			split.OvertimeHours = hours - split.RegularHours
+			dailyRegular[dateKey] += split.RegularHours
+			weeklyRegular[weekKey] += split.RegularHours
+		}
+	}
+
+	return splits
+}
+
+func (s *OvertimeService) findRule(userID uuid.UUID, clientID *uuid.UUID) (*models.OvertimeRule, error) {
+	query := database.DB.Where("user_id = ?", userID)
+	if clientID == nil {
+		query = query.Where("client_id IS NULL")
+	} else {
+		query = query.Where("client_id = ?", *clientID)
+	}
+
+	var rule models.OvertimeRule
+	if err := query.First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
@@ -0,0 +1,666 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type PaymentService struct {
+	invoiceService *InvoiceService
+}
+
+func NewPaymentService() *PaymentService {
+	return &PaymentService{
+		invoiceService: NewInvoiceService(),
+	}
+}
+
+var (
+	ErrPaymentNotFound         = errors.New("payment not found")
+	ErrInvalidPaymentMethod    = errors.New("invalid payment method")
+	ErrInvoiceNotPayable       = errors.New("only issued invoices with a balance due can take payments")
+	ErrNoCreditAvailable       = errors.New("client has no credit available in the invoice currency")
+	ErrCreditAlreadyUsed       = errors.New("credit from this payment has already been applied to other invoices")
+	ErrInvalidStatementFile    = errors.New("invalid bank statement file")
+	ErrInvalidDecimalSeparator = errors.New("decimal_separator must be '.' or ','")
+	ErrInvalidDateFormat       = errors.New("date_format must be one of yyyy-mm-dd, dd/mm/yyyy, mm/dd/yyyy, dd.mm.yyyy")
+	ErrInvoiceHasPayments      = errors.New("invoice has payments recorded against it")
+	ErrPaymentAmountRequired   = errors.New("payment amount must be greater than zero")
+)
+
+var paymentMethods = map[models.PaymentMethod]bool{
+	models.PaymentMethodBankTransfer: true,
+	models.PaymentMethodCard:         true,
+	models.PaymentMethodCash:         true,
+	models.PaymentMethodCheque:       true,
+	models.PaymentMethodOther:        true,
+}
+
+type PaymentInput struct {
+	Amount    float64
+	Date      time.Time
+	Method    models.PaymentMethod
+	Reference string
+	Notes     string
+}
+
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func (s *PaymentService) RecordPayment(userID, invoiceID uuid.UUID, input PaymentInput) (*models.Payment, error) {
+	if input.Method == "" {
+		input.Method = models.PaymentMethodBankTransfer
+	}
+	if !paymentMethods[input.Method] {
+		return nil, ErrInvalidPaymentMethod
+	}
+	if roundMoney(input.Amount) <= 0 {
+		return nil, ErrPaymentAmountRequired
+	}
+
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var payment *models.Payment
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		payment, err = recordPayment(tx, invoice, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment.Invoice = *invoice
+	payment.Client = invoice.Client
+	return payment, nil
+}
+
+func recordPayment(tx *gorm.DB, invoice *models.Invoice, input PaymentInput) (*models.Payment, error) {
+	balance := roundMoney(invoice.BalanceDue())
+	if invoice.Status != models.InvoiceStatusIssued || balance <= 0 {
+		return nil, ErrInvoiceNotPayable
+	}
+
+	amount := roundMoney(input.Amount)
+	payment := &models.Payment{
+		UserID:        invoice.UserID,
+		ClientID:      invoice.ClientID,
+		InvoiceID:     invoice.ID,
+		PaymentDate:   datatypes.Date(input.Date),
+		Currency:      invoice.Currency,
+		Amount:        amount,
+		AppliedAmount: math.Min(amount, balance),
+		Method:        input.Method,
+		Reference:     input.Reference,
+		Notes:         input.Notes,
+	}
+	if err := tx.Create(payment).Error; err != nil {
+		return nil, err
+	}
+
+	if credit := roundMoney(payment.CreditAmount()); credit > 0 {
+		entry := &models.ClientCredit{
+			UserID:      invoice.UserID,
+			ClientID:    invoice.ClientID,
+			PaymentID:   &payment.ID,
+			Currency:    invoice.Currency,
+			Amount:      credit,
+			Date:        payment.PaymentDate,
+			Description: fmt.Sprintf("Overpayment on invoice %s", invoice.Number),
+		}
+		if err := tx.Create(entry).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return payment, applyInvoicePayment(tx, invoice, payment.AppliedAmount, input.Date)
+}
+
+func applyInvoicePayment(tx *gorm.DB, invoice *models.Invoice, delta float64, date time.Time) error {
+	invoice.AmountPaid = roundMoney(invoice.AmountPaid + delta)
+	updates := map[string]interface{}{"amount_paid": invoice.AmountPaid}
+
+	if roundMoney(invoice.BalanceDue()) <= 0 {
+		updates["status"] = models.InvoiceStatusPaid
+		updates["paid_at"] = date
+	} else if invoice.Status == models.InvoiceStatusPaid {
+		updates["status"] = models.InvoiceStatusIssued
+		updates["paid_at"] = nil
+	}
+
+	return tx.Model(invoice).Updates(updates).Error
+}
+
+func (s *PaymentService) ApplyCredit(userID, invoiceID uuid.UUID, amount float64, date time.Time) (*models.Payment, error) {
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := roundMoney(invoice.BalanceDue())
+	if invoice.Status != models.InvoiceStatusIssued || balance <= 0 {
+		return nil, ErrInvoiceNotPayable
+	}
+
+	var payment *models.Payment
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var available float64
+		if err := tx.Model(&models.ClientCredit{}).
+			Where("user_id = ? AND client_id = ? AND currency = ?", userID, invoice.ClientID, invoice.Currency).
+			Select("COALESCE(SUM(amount), 0)").Scan(&available).Error; err != nil {
+			return err
+		}
+
+		applied := math.Min(roundMoney(available), balance)
+		if amount > 0 {
+			applied = math.Min(applied, roundMoney(amount))
+		}
+		if applied <= 0 {
+			return ErrNoCreditAvailable
+		}
+
+		payment = &models.Payment{
+			UserID:        userID,
+			ClientID:      invoice.ClientID,
+			InvoiceID:     invoice.ID,
+			PaymentDate:   datatypes.Date(date),
+			Currency:      invoice.Currency,
+			Amount:        applied,
+			AppliedAmount: applied,
+			Method:        models.PaymentMethodCredit,
+		}
+		if err := tx.Create(payment).Error; err != nil {
+			return err
+		}
+
+		entry := &models.ClientCredit{
+			UserID:      userID,
+			ClientID:    invoice.ClientID,
+			PaymentID:   &payment.ID,
+			Currency:    invoice.Currency,
+			Amount:      -applied,
+			Date:        payment.PaymentDate,
+			Description: fmt.Sprintf("Applied to invoice %s", invoice.Number),
+		}
+		if err := tx.Create(entry).Error; err != nil {
+			return err
+		}
+
+		return applyInvoicePayment(tx, invoice, applied, date)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment.Invoice = *invoice
+	payment.Client = invoice.Client
+	return payment, nil
+}
+
+func (s *PaymentService) ListInvoicePayments(userID, invoiceID uuid.UUID) ([]*models.Payment, error) {
+	if _, err := s.invoiceService.GetInvoice(userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	var payments []*models.Payment
+	err := database.DB.Preload("Invoice").Preload("Client").
+		Where("user_id = ? AND invoice_id = ?", userID, invoiceID).
+		Order("payment_date ASC, created_at ASC").
+		Find(&payments).Error
+	return payments, err
+}
+
+func (s *PaymentService) ListPayments(userID uuid.UUID, clientID *uuid.UUID, offset, limit int) ([]*models.Payment, int64, error) {
+	var payments []*models.Payment
+	var total int64
+
+	query := database.DB.Model(&models.Payment{}).Where("user_id = ?", userID)
+	if clientID != nil {
+		query = query.Where("client_id = ?", *clientID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Invoice").Preload("Client").
+		Offset(offset).Limit(limit).
+		Order("payment_date DESC, created_at DESC").
+		Find(&payments).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return payments, total, nil
+}
+
+func (s *PaymentService) DeletePayment(userID, paymentID uuid.UUID) error {
+	var payment models.Payment
+	if err := database.DB.Where("id = ? AND user_id = ?", paymentID, userID).First(&payment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPaymentNotFound
+		}
+		return err
+	}
+
+	invoice, err := s.invoiceService.GetInvoice(userID, payment.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	var creditNotes int64
+	if err := database.DB.Model(&models.CreditNote{}).Where("invoice_id = ?", invoice.ID).Count(&creditNotes).Error; err != nil {
+		return err
+	}
+	if creditNotes > 0 {
+		return ErrInvoiceHasCreditNotes
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if credit := roundMoney(payment.CreditAmount()); credit > 0 {
+			var available float64
+			if err := tx.Model(&models.ClientCredit{}).
+				Where("user_id = ? AND client_id = ? AND currency = ?", userID, payment.ClientID, payment.Currency).
+				Select("COALESCE(SUM(amount), 0)").Scan(&available).Error; err != nil {
+				return err
+			}
+			if roundMoney(available) < credit {
+				return ErrCreditAlreadyUsed
+			}
+		}
+
+		if err := tx.Where("payment_id = ?", payment.ID).Delete(&models.ClientCredit{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&payment).Error; err != nil {
+			return err
+		}
+
+		if invoice.Status == models.InvoiceStatusVoid {
+			return nil
+		}
+		return applyInvoicePayment(tx, invoice, -payment.AppliedAmount, time.Now())
+	})
+}
+
+func (s *PaymentService) GetClientCredit(userID, clientID uuid.UUID) (*models.Client, map[string]float64, error) {
+	client, err := NewClientService().GetClientByID(userID, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type creditTotal struct {
+		Currency string
+		Amount   float64
+	}
+
+	var totals []creditTotal
+	if err := database.DB.Model(&models.ClientCredit{}).
+		Select("currency, SUM(amount) AS amount").
+		Where("user_id = ? AND client_id = ?", userID, clientID).
+		Group("currency").Scan(&totals).Error; err != nil {
+		return nil, nil, err
+	}
+
+	balances := make(map[string]float64)
+	for _, total := range totals {
+		if amount := roundMoney(total.Amount); amount != 0 {
+			balances[total.Currency] = amount
+		}
+	}
+	return client, balances, nil
+}
+
+type AgingBuckets struct {
+	Days0To30   float64
+	Days31To60  float64
+	Days61To90  float64
+	Days90Plus  float64
+	Overdue     float64
+	Outstanding float64
+}
+
+func (b *AgingBuckets) add(amount float64, age int, overdue bool) {
+	switch {
+	case age <= 30:
+		b.Days0To30 += amount
+	case age <= 60:
+		b.Days31To60 += amount
+	case age <= 90:
+		b.Days61To90 += amount
+	default:
+		b.Days90Plus += amount
+	}
+	if overdue {
+		b.Overdue += amount
+	}
+	b.Outstanding += amount
+}
+
+type ClientAging struct {
+	Client       models.Client
+	Currency     string
+	Buckets      AgingBuckets
+	InvoiceCount int
+	Credit       float64
+}
+
+func (s *PaymentService) GetAgingReport(userID uuid.UUID, asOf time.Time) ([]*ClientAging, map[string]*AgingBuckets, error) {
+	type openInvoice struct {
+		ID        uuid.UUID
+		ClientID  uuid.UUID
+		Currency  string
+		IssueDate datatypes.Date
+		DueDate   *datatypes.Date
+		Total     float64
+		Paid      float64
+		Credited  float64
+	}
+
+	paid := database.DB.Model(&models.Payment{}).
+		Select("invoice_id, SUM(applied_amount) AS paid").
+		Where("user_id = ? AND payment_date <= ?", userID, datatypes.Date(asOf)).
+		Group("invoice_id")
+
+	credited := database.DB.Model(&models.CreditNote{}).
+		Select("invoice_id, SUM(applied_amount) AS credited").
+		Where("user_id = ? AND issue_date <= ?", userID, datatypes.Date(asOf)).
+		Group("invoice_id")
+
+	var invoices []openInvoice
+	err := database.DB.Model(&models.Invoice{}).
+		Select("invoices.id, invoices.client_id, invoices.currency, invoices.issue_date, invoices.due_date, invoices.total, COALESCE(paid.paid, 0) AS paid, COALESCE(credited.credited, 0) AS credited").
+		Joins("LEFT JOIN (?) AS paid ON paid.invoice_id = invoices.id", paid).
+		Joins("LEFT JOIN (?) AS credited ON credited.invoice_id = invoices.id", credited).
+		Where("invoices.user_id = ? AND invoices.status IN ?", userID, []models.InvoiceStatus{models.InvoiceStatusIssued, models.InvoiceStatusPaid, models.InvoiceStatusCredited}).
+		Where("invoices.issue_date <= ?", datatypes.Date(asOf)).
+		Where("invoices.total - COALESCE(paid.paid, 0) - COALESCE(credited.credited, 0) > 0.005").
+		Scan(&invoices).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type agingKey struct {
+		ClientID uuid.UUID
+		Currency string
+	}
+
+	rows := make(map[agingKey]*ClientAging)
+	var clientIDs []uuid.UUID
+	for _, invoice := range invoices {
+		key := agingKey{ClientID: invoice.ClientID, Currency: invoice.Currency}
+		row, exists := rows[key]
+		if !exists {
+			row = &ClientAging{Currency: invoice.Currency}
+			rows[key] = row
+			clientIDs = append(clientIDs, invoice.ClientID)
+		}
+
+		agedFrom, overdue := time.Time(invoice.IssueDate), false
+		if invoice.DueDate != nil {
+			agedFrom = time.Time(*invoice.DueDate)
+			overdue = asOf.After(agedFrom)
+		}
+		age := int(asOf.Sub(agedFrom).Hours() / 24)
+		row.Buckets.add(roundMoney(invoice.Total-invoice.Paid-invoice.Credited), age, overdue)
+		row.InvoiceCount++
+	}
+
+	var clients []models.Client
+	if len(clientIDs) > 0 {
+		if err := database.DB.Unscoped().Where("id IN ?", clientIDs).Find(&clients).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+	byID := make(map[uuid.UUID]models.Client)
+	for _, client := range clients {
+		byID[client.ID] = client
+	}
+
+	type creditTotal struct {
+		ClientID uuid.UUID
+		Currency string
+		Amount   float64
+	}
+
+	var credits []creditTotal
+	if err := database.DB.Model(&models.ClientCredit{}).
+		Select("client_id, currency, SUM(amount) AS amount").
+		Where("user_id = ? AND date <= ?", userID, datatypes.Date(asOf)).
+		Group("client_id, currency").Scan(&credits).Error; err != nil {
+		return nil, nil, err
+	}
+	for _, credit := range credits {
+		if row, exists := rows[agingKey{ClientID: credit.ClientID, Currency: credit.Currency}]; exists {
+			row.Credit = roundMoney(credit.Amount)
+		}
+	}
+
+	result := make([]*ClientAging, 0, len(rows))
+	totals := make(map[string]*AgingBuckets)
+	for key, row := range rows {
+		row.Client = byID[key.ClientID]
+		result = append(result, row)
+
+		total, exists := totals[row.Currency]
+		if !exists {
+			total = &AgingBuckets{}
+			totals[row.Currency] = total
+		}
+		total.Days0To30 += row.Buckets.Days0To30
+		total.Days31To60 += row.Buckets.Days31To60
+		total.Days61To90 += row.Buckets.Days61To90
+		total.Days90Plus += row.Buckets.Days90Plus
+		total.Overdue += row.Buckets.Overdue
+		total.Outstanding += row.Buckets.Outstanding
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Client.Name != result[j].Client.Name {
+			return result[i].Client.Name < result[j].Client.Name
+		}
+		return result[i].Currency < result[j].Currency
+	})
+
+	return result, totals, nil
+}
+
+type StatementLine struct {
+	Line        int
+	Date        time.Time
+	Amount      float64
+	Description string
+	Matches     []*InvoiceMatch
+}
+
+type InvoiceMatch struct {
+	Invoice models.Invoice
+	Score   int
+	Reasons []string
+}
+
+var statementDateLayouts = map[string]string{
+	"yyyy-mm-dd": "2006-01-02",
+	"dd/mm/yyyy": "02/01/2006",
+	"mm/dd/yyyy": "01/02/2006",
+	"dd.mm.yyyy": "02.01.2006",
+}
+
+type StatementFormat struct {
+	DecimalSeparator string
+	DateFormat       string
+}
+
+func (f StatementFormat) validate() error {
+	if f.DecimalSeparator != "." && f.DecimalSeparator != "," {
+		return ErrInvalidDecimalSeparator
+	}
+	if _, ok := statementDateLayouts[f.DateFormat]; !ok {
+		return ErrInvalidDateFormat
+	}
+	return nil
+}
+
+func (s *PaymentService) MatchStatement(userID uuid.UUID, r io.Reader, format StatementFormat) ([]*StatementLine, error) {
+	if err := format.validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxUploadSize {
+		return nil, ErrFileTooLarge
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, ErrFileEmpty
+	}
+
+	lines, err := parseStatement(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoices []models.Invoice
+	if err := database.DB.Preload("Client").
+		Where("user_id = ? AND status = ? AND total - amount_paid - amount_credited > 0.005", userID, models.InvoiceStatusIssued).
+		Order("issue_date ASC").
+		Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		if line.Amount <= 0 {
+			continue
+		}
+		description := strings.ToLower(line.Description)
+
+		for _, invoice := range invoices {
+			match := &InvoiceMatch{Invoice: invoice}
+			if invoice.Number != "" && strings.Contains(description, strings.ToLower(invoice.Number)) {
+				match.Score += 60
+				match.Reasons = append(match.Reasons, "invoice number in reference")
+			}
+			if math.Abs(line.Amount-roundMoney(invoice.BalanceDue())) < 0.005 {
+				match.Score += 30
+				match.Reasons = append(match.Reasons, "amount equals balance due")
+			} else if line.Amount < invoice.BalanceDue() && match.Score > 0 {
+				match.Score += 5
+				match.Reasons = append(match.Reasons, "partial amount")
+			}
+			if containsWord(description, invoice.Client.Name) || containsWord(description, invoice.Client.Code) {
+				match.Score += 20
+				match.Reasons = append(match.Reasons, "client named in reference")
+			}
+			if match.Score >= 30 {
+				line.Matches = append(line.Matches, match)
+			}
+		}
+
+		sort.SliceStable(line.Matches, func(i, j int) bool {
+			return line.Matches[i].Score > line.Matches[j].Score
+		})
+		if len(line.Matches) > 3 {
+			line.Matches = line.Matches[:3]
+		}
+	}
+
+	return lines, nil
+}
+
+func containsWord(text, word string) bool {
+	word = strings.ToLower(strings.TrimSpace(word))
+	return len(word) >= 3 && strings.Contains(text, word)
+}
+
+func parseStatement(data []byte, format StatementFormat) ([]*StatementLine, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimSpace(data)))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatementFile, err)
+	}
+
+	columns := map[string]int{"date": -1, "amount": -1, "description": -1}
+	aliases := map[string]string{
+		"date": "date", "booking date": "date", "transaction date": "date", "value date": "date",
+		"amount": "amount", "credit": "amount",
+		"description": "description", "reference": "description", "memo": "description", "details": "description", "payee": "description",
+	}
+	for i, name := range header {
+		if column, ok := aliases[strings.ToLower(strings.TrimSpace(name))]; ok && columns[column] < 0 {
+			columns[column] = i
+		}
+	}
+	for _, name := range []string{"date", "amount"} {
+		if columns[name] < 0 {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidStatementFile, name)
+		}
+	}
+
+	var lines []*StatementLine
+	for number := 2; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStatementFile, err)
+		}
+		if columns["date"] >= len(record) || columns["amount"] >= len(record) {
+			return nil, fmt.Errorf("%w: line %d: missing fields", ErrInvalidStatementFile, number)
+		}
+
+		date, err := time.Parse(statementDateLayouts[format.DateFormat], strings.TrimSpace(record[columns["date"]]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid date", ErrInvalidStatementFile, number)
+		}
+		amount, err := parseStatementAmount(record[columns["amount"]], format.DecimalSeparator)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid amount", ErrInvalidStatementFile, number)
+		}
+
+		line := &StatementLine{Line: number, Date: date, Amount: amount}
+		if index := columns["description"]; index >= 0 && index < len(record) {
+			line.Description = strings.TrimSpace(record[index])
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func parseStatementAmount(value, decimalSeparator string) (float64, error) {
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+
+	value = strings.Trim(strings.TrimSpace(value), "€$£ ")
+	value = strings.ReplaceAll(value, thousandsSeparator, "")
+	value = strings.ReplaceAll(value, decimalSeparator, ".")
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	return roundMoney(amount), nil
+}
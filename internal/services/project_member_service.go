@@ -0,0 +1,103 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ProjectMemberService struct{}
+
+func NewProjectMemberService() *ProjectMemberService {
+	return &ProjectMemberService{}
+}
+
+var (
+	ErrProjectMemberNotFound = errors.New("user is not a member of this project")
+	ErrProjectMemberExists   = errors.New("user is already a member of this project")
+)
+
+func (s *ProjectMemberService) ListMembers(userID, projectID uuid.UUID) ([]*models.ProjectMember, error) {
+	if _, err := NewProjectService().GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	var members []*models.ProjectMember
+	err := database.DB.Preload("User").
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+func (s *ProjectMemberService) AddMember(userID, projectID uuid.UUID, username, email string) (*models.ProjectMember, error) {
+	project, err := NewProjectService().GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	err = database.DB.Where("username = ? AND LOWER(email) = ? AND is_active = ?",
+		strings.TrimSpace(username), strings.ToLower(strings.TrimSpace(email)), true).First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, err
+	}
+
+	if user.ID == project.UserID || isProjectMember(projectID, user.ID) {
+		return nil, ErrProjectMemberExists
+	}
+
+	member := &models.ProjectMember{
+		ProjectID: projectID,
+		UserID:    user.ID,
+	}
+	if err := database.DB.Create(member).Error; err != nil {
+		return nil, err
+	}
+
+	member.User = user
+	return member, nil
+}
+
+func (s *ProjectMemberService) RemoveMember(userID, projectID, memberID uuid.UUID) error {
+	if _, err := NewProjectService().GetProjectByID(userID, projectID); err != nil {
+		return err
+	}
+
+	result := database.DB.Unscoped().Where("project_id = ? AND user_id = ?", projectID, memberID).Delete(&models.ProjectMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrProjectMemberNotFound
+	}
+	return nil
+}
+
+func isProjectMember(projectID, userID uuid.UUID) bool {
+	var count int64
+	database.DB.Model(&models.ProjectMember{}).Where("project_id = ? AND user_id = ?", projectID, userID).Count(&count)
+	return count > 0
+}
+
+func memberProject(userID, projectID uuid.UUID) (*models.Project, error) {
+	var project models.Project
+	err := database.DB.Where("id = ?", projectID).
+		Where("user_id = ? OR id IN (?)", userID,
+			database.DB.Model(&models.ProjectMember{}).Select("project_id").Where("user_id = ?", userID)).
+		First(&project).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+	return &project, nil
+}
@@ -0,0 +1,353 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ProjectRateService struct{}
+
+func NewProjectRateService() *ProjectRateService {
+	return &ProjectRateService{}
+}
+
+var (
+	ErrProjectRateNotFound = errors.New("project rate not found")
+	ErrLastProjectRate     = errors.New("a project must keep at least one rate")
+	ErrMemberNotFound      = errors.New("no active user matches that username and email")
+)
+
+func (s *ProjectRateService) ListRates(userID, projectID uuid.UUID) (*models.Project, []*models.ProjectRate, error) {
+	project, err := NewProjectService().GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rates []*models.ProjectRate
+	if err := database.DB.Where("project_id = ?", projectID).Order("effective_from ASC").Find(&rates).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return project, rates, nil
+}
+
+func (s *ProjectRateService) SetRate(userID, projectID uuid.UUID, rate float64, effectiveFrom time.Time) (*models.ProjectRate, error) {
+	project, err := NewProjectService().GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectRate *models.ProjectRate
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		projectRate, err = setProjectRate(tx, project, rate, effectiveFrom)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectRate, nil
+}
+
+func (s *ProjectRateService) DeleteRate(userID, projectID, rateID uuid.UUID) error {
+	project, err := NewProjectService().GetProjectByID(userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		var projectRate models.ProjectRate
+		if err := tx.Where("id = ? AND project_id = ?", rateID, projectID).First(&projectRate).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProjectRateNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.ProjectRate{}).Where("project_id = ?", projectID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count <= 1 {
+			return ErrLastProjectRate
+		}
+
+		if err := tx.Unscoped().Delete(&projectRate).Error; err != nil {
+			return err
+		}
+		return syncCurrentRate(tx, project)
+	})
+}
+
+func (s *ProjectRateService) ListMemberRates(userID, projectID uuid.UUID) ([]*models.ProjectMemberRate, error) {
+	if _, err := NewProjectService().GetProjectByID(userID, projectID); err != nil {
+		return nil, err
+	}
+
+	var rates []*models.ProjectMemberRate
+	err := database.DB.Preload("User").
+		Where("project_id = ?", projectID).
+		Order("effective_from ASC").
+		Find(&rates).Error
+	return rates, err
+}
+
+func (s *ProjectRateService) SetMemberRate(userID, projectID, memberID uuid.UUID, rate float64, effectiveFrom time.Time) (*models.ProjectMemberRate, error) {
+	project, err := NewProjectService().GetProjectByID(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberID != project.UserID && !isProjectMember(projectID, memberID) {
+		return nil, ErrProjectMemberNotFound
+	}
+
+	var member models.User
+	if err := database.DB.First(&member, "id = ?", memberID).Error; err != nil {
+		return nil, err
+	}
+
+	var memberRate models.ProjectMemberRate
+	err = database.DB.Where("project_id = ? AND user_id = ? AND effective_from = ?",
+		projectID, member.ID, datatypes.Date(effectiveFrom)).First(&memberRate).Error
+	switch {
+	case err == nil:
+		if err := database.DB.Model(&memberRate).Update("rate", rate).Error; err != nil {
+			return nil, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		memberRate = models.ProjectMemberRate{
+			ProjectID:     projectID,
+			UserID:        member.ID,
+			Rate:          rate,
+			EffectiveFrom: datatypes.Date(effectiveFrom),
+		}
+		if err := database.DB.Create(&memberRate).Error; err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	memberRate.User = member
+	return &memberRate, nil
+}
+
+func (s *ProjectRateService) DeleteMemberRate(userID, projectID, rateID uuid.UUID) error {
+	if _, err := NewProjectService().GetProjectByID(userID, projectID); err != nil {
+		return err
+	}
+
+	result := database.DB.Unscoped().Where("id = ? AND project_id = ?", rateID, projectID).Delete(&models.ProjectMemberRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrProjectRateNotFound
+	}
+	return nil
+}
+
+func setProjectRate(tx *gorm.DB, project *models.Project, rate float64, effectiveFrom time.Time) (*models.ProjectRate, error) {
+	if err := ensureRateBaseline(tx, project); err != nil {
+		return nil, err
+	}
+
+	var projectRate models.ProjectRate
+	err := tx.Where("project_id = ? AND effective_from = ?", project.ID, datatypes.Date(effectiveFrom)).First(&projectRate).Error
+	switch {
+	case err == nil:
+		if err := tx.Model(&projectRate).Update("rate", rate).Error; err != nil {
+			return nil, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		projectRate = models.ProjectRate{
+			ProjectID:     project.ID,
+			Rate:          rate,
+			EffectiveFrom: datatypes.Date(effectiveFrom),
+		}
+		if err := tx.Create(&projectRate).Error; err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err := syncCurrentRate(tx, project); err != nil {
+		return nil, err
+	}
+	return &projectRate, nil
+}
+
+func ensureRateBaseline(tx *gorm.DB, project *models.Project) error {
+	var count int64
+	if err := tx.Model(&models.ProjectRate{}).Where("project_id = ?", project.ID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return tx.Create(&models.ProjectRate{
+		ProjectID:     project.ID,
+		Rate:          project.BillableRate,
+		EffectiveFrom: project.StartDate,
+	}).Error
+}
+
+func syncCurrentRate(tx *gorm.DB, project *models.Project) error {
+	var rates []models.ProjectRate
+	if err := tx.Where("project_id = ?", project.ID).Order("effective_from ASC").Find(&rates).Error; err != nil {
+		return err
+	}
+
+	current := project.BillableRate
+	if len(rates) > 0 {
+		current = projectRateHistory(rates).at(time.Now().UTC())
+	}
+	if current == project.BillableRate {
+		return nil
+	}
+
+	project.BillableRate = current
+	return tx.Model(project).Update("billable_rate", current).Error
+}
+
+type datedRate struct {
+	EffectiveFrom time.Time
+	Rate          float64
+}
+
+type rateHistory []datedRate
+
+func projectRateHistory(rates []models.ProjectRate) rateHistory {
+	history := make(rateHistory, len(rates))
+	for i, rate := range rates {
+		history[i] = datedRate{EffectiveFrom: time.Time(rate.EffectiveFrom), Rate: rate.Rate}
+	}
+	return history
+}
+
+func (h rateHistory) find(date time.Time) (float64, bool) {
+	rate, found := 0.0, false
+	for _, entry := range h {
+		if entry.EffectiveFrom.After(date) {
+			break
+		}
+		rate, found = entry.Rate, true
+	}
+	return rate, found
+}
+
+func (h rateHistory) at(date time.Time) float64 {
+	if rate, found := h.find(date); found {
+		return rate
+	}
+	return h[0].Rate
+}
+
+type memberKey struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type rateResolver struct {
+	projectRates map[uuid.UUID]rateHistory
+	memberRates  map[memberKey]rateHistory
+	clientRates  map[uuid.UUID]float64
+}
+
+func loadRateResolver(projectIDs []uuid.UUID) (*rateResolver, error) {
+	resolver := &rateResolver{
+		projectRates: make(map[uuid.UUID]rateHistory),
+		memberRates:  make(map[memberKey]rateHistory),
+		clientRates:  make(map[uuid.UUID]float64),
+	}
+	if len(projectIDs) == 0 {
+		return resolver, nil
+	}
+
+	var rates []models.ProjectRate
+	if err := database.DB.Where("project_id IN ?", projectIDs).Order("effective_from ASC").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+	for _, rate := range rates {
+		resolver.projectRates[rate.ProjectID] = append(resolver.projectRates[rate.ProjectID],
+			datedRate{EffectiveFrom: time.Time(rate.EffectiveFrom), Rate: rate.Rate})
+	}
+
+	var memberRates []models.ProjectMemberRate
+	if err := database.DB.Where("project_id IN ?", projectIDs).Order("effective_from ASC").Find(&memberRates).Error; err != nil {
+		return nil, err
+	}
+	for _, rate := range memberRates {
+		key := memberKey{ProjectID: rate.ProjectID, UserID: rate.UserID}
+		resolver.memberRates[key] = append(resolver.memberRates[key],
+			datedRate{EffectiveFrom: time.Time(rate.EffectiveFrom), Rate: rate.Rate})
+	}
+
+	type clientRate struct {
+		ProjectID   uuid.UUID
+		DefaultRate float64
+	}
+
+	var clientRates []clientRate
+	err := database.DB.Model(&models.Project{}).Unscoped().
+		Select("projects.id AS project_id, clients.default_rate").
+		Joins("JOIN clients ON clients.id = projects.client_id").
+		Where("projects.id IN ?", projectIDs).
+		Scan(&clientRates).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, rate := range clientRates {
+		resolver.clientRates[rate.ProjectID] = rate.DefaultRate
+	}
+
+	return resolver, nil
+}
+
+// rate resolves the billable rate for a consultant's time on a date: the member
+// override in effect, else the project rate in effect (a dated rate of 0 means
+// the work is free), else the project's base rate, else the client's default
+// rate. effectiveRateSQL must follow the same order so SQL aggregates agree
+// with per-entry pricing.
+func (r *rateResolver) rate(project *models.Project, userID uuid.UUID, date time.Time) float64 {
+	if rate, found := r.memberRates[memberKey{ProjectID: project.ID, UserID: userID}].find(date); found {
+		return rate
+	}
+	if history := r.projectRates[project.ID]; len(history) > 0 {
+		return history.at(date)
+	}
+	if project.BillableRate > 0 {
+		return project.BillableRate
+	}
+	return r.clientRates[project.ID]
+}
+
+// effectiveRateSQL is the SQL form of rateResolver.rate for a time_entries row;
+// keep the two in step when the precedence changes.
+const effectiveRateSQL = `COALESCE(
+	(SELECT rate FROM project_member_rates
+		WHERE project_member_rates.project_id = time_entries.project_id
+			AND project_member_rates.user_id = time_entries.user_id
+			AND project_member_rates.effective_from <= time_entries.date
+			AND project_member_rates.deleted_at IS NULL
+		ORDER BY project_member_rates.effective_from DESC LIMIT 1),
+	(SELECT rate FROM project_rates
+		WHERE project_rates.project_id = time_entries.project_id
+			AND project_rates.effective_from <= time_entries.date
+			AND project_rates.deleted_at IS NULL
+		ORDER BY project_rates.effective_from DESC LIMIT 1),
+	(SELECT rate FROM project_rates
+		WHERE project_rates.project_id = time_entries.project_id
+			AND project_rates.deleted_at IS NULL
+		ORDER BY project_rates.effective_from ASC LIMIT 1),
+	NULLIF(projects.billable_rate, 0),
+	clients.default_rate, 0)`
@@ -22,11 +22,80 @@ var (
 	ErrProjectNotFound      = errors.New("project not found")
 	ErrProjectCodeExists    = errors.New("project code already exists")
 	ErrInvalidProjectStatus = errors.New("invalid project status")
+	ErrProjectDeleted       = errors.New("project is in the trash")
+	ErrProjectHasRecords    = errors.New("project still has allocations or time entries")
+	ErrInvalidBillingType   = errors.New("invalid billing type")
+	ErrInvalidBillingTerms  = errors.New("fixed fee projects need a fee and retainers need included hours")
 )
 
-func (s *ProjectService) CreateProject(userID, clientID uuid.UUID, name, code, description string, billableRate float64, currency string, startDate time.Time, endDate *time.Time) (*models.Project, error) {
+type BillingTerms struct {
+	Type           models.BillingType
+	FixedFee       float64
+	RetainerFee    float64
+	RetainerHours  float64
+	OverageRate    float64
+	RolloverPolicy models.RolloverPolicy
+	RolloverCap    float64
+}
+
+func (t BillingTerms) normalize() (BillingTerms, error) {
+	if t.Type == "" {
+		t.Type = models.BillingTypeHourly
+	}
+	if t.RolloverPolicy == "" {
+		t.RolloverPolicy = models.RolloverPolicyNone
+	}
+
+	switch t.Type {
+	case models.BillingTypeHourly:
+	case models.BillingTypeFixedFee:
+		if t.FixedFee <= 0 {
+			return t, ErrInvalidBillingTerms
+		}
+	case models.BillingTypeRetainer:
+		if t.RetainerHours <= 0 {
+			return t, ErrInvalidBillingTerms
+		}
+	default:
+		return t, ErrInvalidBillingType
+	}
+
+	if t.RolloverPolicy != models.RolloverPolicyNone && t.RolloverPolicy != models.RolloverPolicyCarry {
+		return t, ErrInvalidBillingTerms
+	}
+	return t, nil
+}
+
+func (t BillingTerms) apply(project *models.Project) {
+	project.BillingType = t.Type
+	project.FixedFee = t.FixedFee
+	project.RetainerFee = t.RetainerFee
+	project.RetainerHours = t.RetainerHours
+	project.OverageRate = t.OverageRate
+	project.RolloverPolicy = t.RolloverPolicy
+	project.RolloverCap = t.RolloverCap
+}
+
+func (t BillingTerms) updates() map[string]interface{} {
+	return map[string]interface{}{
+		"billing_type":    t.Type,
+		"fixed_fee":       t.FixedFee,
+		"retainer_fee":    t.RetainerFee,
+		"retainer_hours":  t.RetainerHours,
+		"overage_rate":    t.OverageRate,
+		"rollover_policy": t.RolloverPolicy,
+		"rollover_cap":    t.RolloverCap,
+	}
+}
+
+func (s *ProjectService) CreateProject(userID, clientID uuid.UUID, name, code, description string, billableRate float64, currency string, startDate time.Time, endDate *time.Time, terms BillingTerms) (*models.Project, error) {
 	code = strings.ToUpper(strings.TrimSpace(code))
 
+	terms, err := terms.normalize()
+	if err != nil {
+		return nil, err
+	}
+
 	var client models.Client
 	if err := database.DB.Where("id = ? AND user_id = ?", clientID, userID).First(&client).Error; err != nil {
 		return nil, errors.New("client not found or access denied")
@@ -49,13 +118,20 @@ func (s *ProjectService) CreateProject(userID, clientID uuid.UUID, name, code, d
 		ClientID:     clientID,
 		UserID:       userID,
 	}
+	terms.apply(project)
 
 	if endDate != nil {
 		endDateValue := datatypes.Date(*endDate)
 		project.EndDate = &endDateValue
 	}
 
-	if err := database.DB.Create(project).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(project).Error; err != nil {
+			return err
+		}
+		return ensureRateBaseline(tx, project)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -143,7 +219,39 @@ func (s *ProjectService) UpdateProject(userID, projectID uuid.UUID, updates map[
 		updates["status"] = projectStatus
 	}
 
-	if err := database.DB.Model(&project).Updates(updates).Error; err != nil {
+	if terms, ok := updates["billing"].(BillingTerms); ok {
+		terms, err := terms.normalize()
+		if err != nil {
+			return nil, err
+		}
+		delete(updates, "billing")
+		for column, value := range terms.updates() {
+			updates[column] = value
+		}
+	}
+
+	rate, rateChanged := updates["billable_rate"].(float64)
+	rateEffectiveFrom, ok := updates["rate_effective_from"].(time.Time)
+	if !ok {
+		rateEffectiveFrom = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	delete(updates, "billable_rate")
+	delete(updates, "rate_effective_from")
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if len(updates) > 0 {
+			if err := tx.Model(&project).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+		if rateChanged {
+			if _, err := setProjectRate(tx, &project, rate, rateEffectiveFrom); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -177,3 +285,98 @@ func (s *ProjectService) UpdateProjectStatus(userID, projectID uuid.UUID, status
 	}
 	return s.UpdateProject(userID, projectID, updates)
 }
+
+func (s *ProjectService) ListDeletedProjects(userID uuid.UUID, offset, limit int) ([]*models.Project, int64, error) {
+	var projects []*models.Project
+	var total int64
+
+	query := database.DB.Unscoped().Model(&models.Project{}).
+		Preload("Client", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("deleted_at DESC").Find(&projects).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return projects, total, nil
+}
+
+func (s *ProjectService) RestoreProject(userID, projectID uuid.UUID) (*models.Project, error) {
+	project, err := s.getDeletedProject(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var client models.Client
+	if err := database.DB.Where("id = ? AND user_id = ?", project.ClientID, userID).First(&client).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrClientDeleted
+		}
+		return nil, err
+	}
+
+	var existing models.Project
+	if err := database.DB.Where("code = ? AND user_id = ? AND id != ?", project.Code, userID, projectID).First(&existing).Error; err == nil {
+		return nil, ErrProjectCodeExists
+	}
+
+	if err := database.DB.Unscoped().Model(project).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetProjectByID(userID, projectID)
+}
+
+func (s *ProjectService) PurgeProject(userID, projectID uuid.UUID) error {
+	project, err := s.getDeletedProject(userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	var allocationCount, timeEntryCount int64
+	if err := database.DB.Unscoped().Model(&models.Allocation{}).Where("project_id = ?", projectID).Count(&allocationCount).Error; err != nil {
+		return err
+	}
+	if err := database.DB.Unscoped().Model(&models.TimeEntry{}).Where("project_id = ?", projectID).Count(&timeEntryCount).Error; err != nil {
+		return err
+	}
+	if allocationCount > 0 || timeEntryCount > 0 {
+		return ErrProjectHasRecords
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&models.ProjectRate{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&models.ProjectMemberRate{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&models.ProjectMilestone{}).Error; err != nil {
+			return err
+		}
+		budgets := tx.Unscoped().Model(&models.ProjectBudget{}).Select("id").Where("project_id = ?", projectID)
+		if err := tx.Where("budget_id IN (?)", budgets).Delete(&models.BudgetAlert{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&models.ProjectBudget{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(project).Error
+	})
+}
+
+func (s *ProjectService) getDeletedProject(userID, projectID uuid.UUID) (*models.Project, error) {
+	var project models.Project
+	err := database.DB.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", projectID, userID).First(&project).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+	return &project, nil
+}
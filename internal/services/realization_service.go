@@ -0,0 +1,292 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type RealizationService struct{}
+
+func NewRealizationService() *RealizationService {
+	return &RealizationService{}
+}
+
+type RealizationTotals struct {
+	LoggedHours      float64
+	LoggedValue      float64
+	WrittenDownHours float64
+	WrittenDownValue float64
+	BilledHours      float64
+	BilledValue      float64
+	UnbilledHours    float64
+	UnbilledValue    float64
+	InvoicedValue    float64
+}
+
+func (t *RealizationTotals) Realization() float64 {
+	if t.InvoicedValue == 0 {
+		return 0
+	}
+	return math.Round(t.BilledValue/t.InvoicedValue*1000) / 10
+}
+
+func (t *RealizationTotals) add(other RealizationTotals) {
+	t.LoggedHours += other.LoggedHours
+	t.LoggedValue += other.LoggedValue
+	t.WrittenDownHours += other.WrittenDownHours
+	t.WrittenDownValue += other.WrittenDownValue
+	t.BilledHours += other.BilledHours
+	t.BilledValue += other.BilledValue
+	t.UnbilledHours += other.UnbilledHours
+	t.UnbilledValue += other.UnbilledValue
+	t.InvoicedValue += other.InvoicedValue
+}
+
+type ProjectRealization struct {
+	Project models.Project
+	RealizationTotals
+}
+
+type ConsultantRealization struct {
+	User     models.User
+	Currency string
+	RealizationTotals
+}
+
+type RealizationReport struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	Projects    []*ProjectRealization
+	Consultants []*ConsultantRealization
+	ByReason    map[models.WriteDownReason]float64
+}
+
+type billedLine struct {
+	ID                 uuid.UUID
+	Hours              float64
+	Rate               float64
+	Amount             float64
+	EntryHours         float64
+	CreditedHours      float64
+	CreditedAmount     float64
+	WriteDownHours     float64
+	WriteDownsByReason map[models.WriteDownReason]float64
+}
+
+func (s *RealizationService) GetRealizationReport(userID uuid.UUID, startDate, endDate time.Time) (*RealizationReport, error) {
+	var entries []*models.TimeEntry
+	err := database.DB.Preload("Project.Client").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Where("projects.user_id = ? AND time_entries.is_billable = ?", userID, true).
+		Where("time_entries.date >= ? AND time_entries.date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RealizationReport{
+		StartDate: startDate,
+		EndDate:   endDate,
+		ByReason:  make(map[models.WriteDownReason]float64),
+	}
+	if len(entries) == 0 {
+		return report, nil
+	}
+
+	var projectIDs, entryIDs, lineIDs []uuid.UUID
+	userIDs := make(map[uuid.UUID]bool)
+	for _, entry := range entries {
+		projectIDs = append(projectIDs, entry.ProjectID)
+		entryIDs = append(entryIDs, entry.ID)
+		userIDs[entry.UserID] = true
+		if entry.InvoiceLineID != nil {
+			lineIDs = append(lineIDs, *entry.InvoiceLineID)
+		}
+	}
+
+	rates, err := loadRateResolver(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := s.loadBilledLines(lineIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var writeDowns []models.WriteDown
+	if err := database.DB.Where("time_entry_id IN ?", entryIDs).Find(&writeDowns).Error; err != nil {
+		return nil, err
+	}
+	for _, writeDown := range writeDowns {
+		report.ByReason[writeDown.Reason] += writeDown.Hours
+	}
+
+	type consultantKey struct {
+		UserID   uuid.UUID
+		Currency string
+	}
+
+	projects := make(map[uuid.UUID]*ProjectRealization)
+	consultants := make(map[consultantKey]*ConsultantRealization)
+	for _, entry := range entries {
+		project := entry.Project
+		rate := rates.rate(&project, entry.UserID, time.Time(entry.Date))
+
+		totals := RealizationTotals{
+			LoggedHours:      entry.Hours,
+			LoggedValue:      entry.Hours * rate,
+			WrittenDownHours: entry.WrittenDownHours,
+			WrittenDownValue: entry.WrittenDownHours * rate,
+		}
+
+		var line *billedLine
+		if entry.InvoiceLineID != nil {
+			line = lines[*entry.InvoiceLineID]
+		}
+		if line != nil && line.EntryHours > 0 {
+			weight := entry.BillableHours() / line.EntryHours
+			totals.BilledHours = (line.Hours - line.CreditedHours) * weight
+			totals.BilledValue = (line.Amount - line.CreditedAmount) * weight
+			totals.WrittenDownHours += line.WriteDownHours * weight
+			totals.WrittenDownValue += line.WriteDownHours * weight * rate
+			totals.InvoicedValue = entry.Hours * rate
+			for reason, hours := range line.WriteDownsByReason {
+				report.ByReason[reason] += hours * weight
+			}
+		} else {
+			totals.UnbilledHours = entry.BillableHours()
+			totals.UnbilledValue = entry.BillableHours() * rate
+		}
+
+		row, exists := projects[project.ID]
+		if !exists {
+			row = &ProjectRealization{Project: project}
+			projects[project.ID] = row
+		}
+		row.add(totals)
+
+		key := consultantKey{UserID: entry.UserID, Currency: project.Currency}
+		consultant, exists := consultants[key]
+		if !exists {
+			consultant = &ConsultantRealization{Currency: project.Currency}
+			consultant.User.ID = entry.UserID
+			consultants[key] = consultant
+		}
+		consultant.add(totals)
+	}
+
+	ids := make([]uuid.UUID, 0, len(userIDs))
+	for id := range userIDs {
+		ids = append(ids, id)
+	}
+	var users []models.User
+	if err := database.DB.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]models.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+
+	for _, row := range projects {
+		report.Projects = append(report.Projects, row)
+	}
+	for _, row := range consultants {
+		if user, exists := byID[row.User.ID]; exists {
+			row.User = user
+		}
+		report.Consultants = append(report.Consultants, row)
+	}
+
+	sort.Slice(report.Projects, func(i, j int) bool {
+		return report.Projects[i].Project.Name < report.Projects[j].Project.Name
+	})
+	sort.Slice(report.Consultants, func(i, j int) bool {
+		if report.Consultants[i].User.Username != report.Consultants[j].User.Username {
+			return report.Consultants[i].User.Username < report.Consultants[j].User.Username
+		}
+		return report.Consultants[i].Currency < report.Consultants[j].Currency
+	})
+
+	return report, nil
+}
+
+func (s *RealizationService) loadBilledLines(lineIDs []uuid.UUID) (map[uuid.UUID]*billedLine, error) {
+	lines := make(map[uuid.UUID]*billedLine)
+	if len(lineIDs) == 0 {
+		return lines, nil
+	}
+
+	var rows []*billedLine
+	err := database.DB.Model(&models.InvoiceLine{}).
+		Select("invoice_lines.id, invoice_lines.hours, invoice_lines.rate, invoice_lines.amount").
+		Joins("JOIN invoices ON invoices.id = invoice_lines.invoice_id AND invoices.deleted_at IS NULL").
+		Where("invoice_lines.id IN ? AND invoices.status IN ?", lineIDs, []models.InvoiceStatus{
+			models.InvoiceStatusIssued, models.InvoiceStatusPaid, models.InvoiceStatusCredited,
+		}).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return lines, nil
+	}
+
+	billedIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		row.WriteDownsByReason = make(map[models.WriteDownReason]float64)
+		lines[row.ID] = row
+		billedIDs[i] = row.ID
+	}
+
+	type lineTotal struct {
+		LineID uuid.UUID
+		Hours  float64
+		Amount float64
+	}
+
+	var entryHours []lineTotal
+	if err := database.DB.Unscoped().Model(&models.TimeEntry{}).
+		Select("invoice_line_id AS line_id, SUM(hours - written_down_hours) AS hours").
+		Where("invoice_line_id IN ?", billedIDs).
+		Group("invoice_line_id").
+		Scan(&entryHours).Error; err != nil {
+		return nil, err
+	}
+	for _, total := range entryHours {
+		lines[total.LineID].EntryHours = total.Hours
+	}
+
+	var credited []lineTotal
+	if err := database.DB.Model(&models.CreditNoteLine{}).
+		Select("credit_note_lines.invoice_line_id AS line_id, SUM(credit_note_lines.hours) AS hours, SUM(credit_note_lines.amount) AS amount").
+		Joins("JOIN credit_notes ON credit_notes.id = credit_note_lines.credit_note_id AND credit_notes.deleted_at IS NULL").
+		Where("credit_note_lines.invoice_line_id IN ?", billedIDs).
+		Group("credit_note_lines.invoice_line_id").
+		Scan(&credited).Error; err != nil {
+		return nil, err
+	}
+	for _, total := range credited {
+		lines[total.LineID].CreditedHours = total.Hours
+		lines[total.LineID].CreditedAmount = total.Amount
+	}
+
+	var writeDowns []models.WriteDown
+	if err := database.DB.Where("invoice_line_id IN ?", billedIDs).Find(&writeDowns).Error; err != nil {
+		return nil, err
+	}
+	for _, writeDown := range writeDowns {
+		line := lines[*writeDown.InvoiceLineID]
+		line.WriteDownHours += writeDown.Hours
+		line.WriteDownsByReason[writeDown.Reason] += writeDown.Hours
+	}
+
+	return lines, nil
+}
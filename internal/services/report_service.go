@@ -0,0 +1,511 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ReportService struct{}
+
+func NewReportService() *ReportService {
+	return &ReportService{}
+}
+
+type DayShortfall struct {
+	Date          time.Time
+	ExpectedHours float64
+	LoggedHours   float64
+}
+
+func (d DayShortfall) MissingHours() float64 {
+	return d.ExpectedHours - d.LoggedHours
+}
+
+type WeekShortfall struct {
+	WeekStarting  time.Time
+	ExpectedHours float64
+	LoggedHours   float64
+	Days          []DayShortfall
+}
+
+type UserTimesheetGap struct {
+	User          models.User
+	ExpectedHours float64
+	LoggedHours   float64
+	Weeks         []*WeekShortfall
+}
+
+func (s *ReportService) workdays(startDate, endDate time.Time, holidays map[string]string) []time.Time {
+	var days []time.Time
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		if holidays[day.Format("2006-01-02")] != "" {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+func (s *ReportService) GetMissingTimesheets(startDate, endDate time.Time, tolerance float64) ([]*UserTimesheetGap, time.Time, time.Time, error) {
+	rangeStart := startDate
+	rangeEnd := endDate
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if rangeEnd.After(today) {
+		rangeEnd = today
+	}
+
+	holidays, err := NewHolidayService().HolidayDates(rangeStart, rangeEnd)
+	if err != nil {
+		return nil, rangeStart, rangeEnd, err
+	}
+	workdays := s.workdays(rangeStart, rangeEnd, holidays)
+
+	var users []models.User
+	if err := database.DB.Where("is_active = ? AND weekly_capacity > 0", true).Order("username ASC").Find(&users).Error; err != nil {
+		return nil, rangeStart, rangeEnd, err
+	}
+
+	userIDs := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+
+	leave, err := NewLeaveService().LeaveDates(userIDs, rangeStart, rangeEnd)
+	if err != nil {
+		return nil, rangeStart, rangeEnd, err
+	}
+
+	type dailyTotal struct {
+		UserID uuid.UUID
+		Date   datatypes.Date
+		Hours  float64
+	}
+
+	var totals []dailyTotal
+	err = database.DB.Model(&models.TimeEntry{}).
+		Select("user_id, date, SUM(hours) AS hours").
+		Where("date >= ? AND date <= ?", datatypes.Date(rangeStart), datatypes.Date(rangeEnd)).
+		Group("user_id, date").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, rangeStart, rangeEnd, err
+	}
+
+	logged := make(map[uuid.UUID]map[string]float64)
+	for _, total := range totals {
+		if logged[total.UserID] == nil {
+			logged[total.UserID] = make(map[string]float64)
+		}
+		logged[total.UserID][time.Time(total.Date).Format("2006-01-02")] += total.Hours
+	}
+
+	var gaps []*UserTimesheetGap
+	for _, user := range users {
+		expected := user.DailyCapacity()
+		gap := &UserTimesheetGap{User: user}
+		weeks := make(map[string]*WeekShortfall)
+
+		for _, day := range workdays {
+			dateKey := day.Format("2006-01-02")
+			if leave[user.ID][dateKey] != "" {
+				continue
+			}
+
+			hours := logged[user.ID][dateKey]
+			if hours >= expected-tolerance {
+				continue
+			}
+
+			weekStart := startOfWeek(day)
+			weekKey := weekStart.Format("2006-01-02")
+			week, exists := weeks[weekKey]
+			if !exists {
+				week = &WeekShortfall{WeekStarting: weekStart}
+				weeks[weekKey] = week
+				gap.Weeks = append(gap.Weeks, week)
+			}
+
+			week.Days = append(week.Days, DayShortfall{
+				Date:          day,
+				ExpectedHours: expected,
+				LoggedHours:   hours,
+			})
+			week.ExpectedHours += expected
+			week.LoggedHours += hours
+			gap.ExpectedHours += expected
+			gap.LoggedHours += hours
+		}
+
+		if len(gap.Weeks) > 0 {
+			gaps = append(gaps, gap)
+		}
+	}
+
+	return gaps, rangeStart, rangeEnd, nil
+}
+
+type ExpenseTotal struct {
+	Currency                string
+	Amount                  float64
+	BillableAmount          float64
+	ConvertedAmount         float64
+	ConvertedBillableAmount float64
+	ByCategory              map[models.ExpenseCategory]float64
+}
+
+type TravelTotal struct {
+	Count          int
+	Quantity       float64
+	Amount         float64
+	BillableAmount float64
+}
+
+type ConvertedTotals struct {
+	Currency              string
+	BillableAmount        float64
+	ExpenseAmount         float64
+	BillableExpenseAmount float64
+	TravelAmount          float64
+	BillableTravelAmount  float64
+	MissingRates          []string
+}
+
+func (t *ConvertedTotals) BillableTotal() float64 {
+	return t.BillableAmount + t.BillableExpenseAmount + t.BillableTravelAmount
+}
+
+func (t *ConvertedTotals) Add(other *ConvertedTotals) {
+	t.BillableAmount += other.BillableAmount
+	t.ExpenseAmount += other.ExpenseAmount
+	t.BillableExpenseAmount += other.BillableExpenseAmount
+	t.TravelAmount += other.TravelAmount
+	t.BillableTravelAmount += other.BillableTravelAmount
+	for _, currency := range other.MissingRates {
+		t.addMissingRate(currency)
+	}
+}
+
+func (t *ConvertedTotals) convert(converter *currencyConverter, amount float64, currency string, date time.Time) float64 {
+	converted, found := converter.convert(amount, currency, date)
+	if !found {
+		t.addMissingRate(strings.ToUpper(currency))
+	}
+	return converted
+}
+
+func (t *ConvertedTotals) addMissingRate(currency string) {
+	index := sort.SearchStrings(t.MissingRates, currency)
+	if index < len(t.MissingRates) && t.MissingRates[index] == currency {
+		return
+	}
+	t.MissingRates = append(t.MissingRates, "")
+	copy(t.MissingRates[index+1:], t.MissingRates[index:])
+	t.MissingRates[index] = currency
+}
+
+type ProjectReport struct {
+	Project          models.Project
+	TotalHours       float64
+	BillableHours    float64
+	WrittenDownHours float64
+	BillableAmount   float64
+	Expenses         []*ExpenseTotal
+	Mileage          TravelTotal
+	PerDiem          TravelTotal
+	Converted        *ConvertedTotals
+}
+
+type ClientReport struct {
+	Client           models.Client
+	Projects         []*ProjectReport
+	TotalHours       float64
+	BillableHours    float64
+	WrittenDownHours float64
+	BillableAmounts  map[string]float64
+	Expenses         []*ExpenseTotal
+	TravelAmounts    map[string]float64
+	Converted        *ConvertedTotals
+}
+
+func (s *ReportService) GetProjectReport(userID, projectID uuid.UUID, startDate, endDate *time.Time, currency string) (*ProjectReport, error) {
+	var project models.Project
+	if err := database.DB.Preload("Client").Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	converter, err := s.loadConverter(userID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.buildProjectReports(userID, []models.Project{project}, startDate, endDate, converter)
+	if err != nil {
+		return nil, err
+	}
+
+	return reports[0], nil
+}
+
+func (s *ReportService) GetClientReport(userID, clientID uuid.UUID, startDate, endDate *time.Time, currency string) (*ClientReport, error) {
+	var client models.Client
+	if err := database.DB.Where("id = ? AND user_id = ?", clientID, userID).First(&client).Error; err != nil {
+		return nil, ErrClientNotFound
+	}
+
+	var projects []models.Project
+	if err := database.DB.Preload("Client").Where("client_id = ? AND user_id = ?", clientID, userID).Order("name ASC").Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	converter, err := s.loadConverter(userID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.buildProjectReports(userID, projects, startDate, endDate, converter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ClientReport{
+		Client:          client,
+		Projects:        reports,
+		BillableAmounts: make(map[string]float64),
+		TravelAmounts:   make(map[string]float64),
+	}
+	if converter != nil {
+		report.Converted = &ConvertedTotals{Currency: converter.target}
+	}
+	for _, project := range reports {
+		report.TotalHours += project.TotalHours
+		report.BillableHours += project.BillableHours
+		report.WrittenDownHours += project.WrittenDownHours
+		if project.BillableAmount > 0 {
+			report.BillableAmounts[project.Project.Currency] += project.BillableAmount
+		}
+		for _, travel := range []TravelTotal{project.Mileage, project.PerDiem} {
+			if travel.BillableAmount > 0 {
+				report.TravelAmounts[project.Project.Currency] += travel.BillableAmount
+			}
+		}
+		report.Expenses = mergeExpenseTotals(report.Expenses, project.Expenses)
+		if converter != nil {
+			report.Converted.Add(project.Converted)
+		}
+	}
+
+	return report, nil
+}
+
+func (s *ReportService) loadConverter(userID uuid.UUID, currency string) (*currencyConverter, error) {
+	if currency == "" {
+		var err error
+		currency, err = NewExchangeRateService().ReportingCurrency(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if currency == "" {
+		return nil, nil
+	}
+	return loadCurrencyConverter(currency)
+}
+
+func (s *ReportService) buildProjectReports(userID uuid.UUID, projects []models.Project, startDate, endDate *time.Time, converter *currencyConverter) ([]*ProjectReport, error) {
+	reports := make([]*ProjectReport, len(projects))
+	projectIDs := make([]uuid.UUID, len(projects))
+	byID := make(map[uuid.UUID]*ProjectReport)
+	for i, project := range projects {
+		reports[i] = &ProjectReport{Project: project}
+		if converter != nil {
+			reports[i].Converted = &ConvertedTotals{Currency: converter.target}
+		}
+		projectIDs[i] = project.ID
+		byID[project.ID] = reports[i]
+	}
+
+	if len(projects) == 0 {
+		return reports, nil
+	}
+
+	rates, err := loadRateResolver(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	type hourTotal struct {
+		ProjectID        uuid.UUID
+		UserID           uuid.UUID
+		Date             datatypes.Date
+		IsBillable       bool
+		Hours            float64
+		WrittenDownHours float64
+	}
+
+	var hours []hourTotal
+	query := database.DB.Model(&models.TimeEntry{}).
+		Select("project_id, user_id, date, is_billable, SUM(hours) AS hours, SUM(written_down_hours) AS written_down_hours").
+		Where("user_id = ? AND project_id IN ?", userID, projectIDs)
+	query = s.applyDateRange(query, startDate, endDate)
+	if err := query.Group("project_id, user_id, date, is_billable").Scan(&hours).Error; err != nil {
+		return nil, err
+	}
+
+	for _, total := range hours {
+		report := byID[total.ProjectID]
+		report.TotalHours += total.Hours
+		if total.IsBillable {
+			billable := total.Hours - total.WrittenDownHours
+			report.BillableHours += billable
+			report.WrittenDownHours += total.WrittenDownHours
+			amount := billable * rates.rate(&report.Project, total.UserID, time.Time(total.Date))
+			report.BillableAmount += amount
+			if converter != nil {
+				report.Converted.BillableAmount += report.Converted.convert(converter, amount, report.Project.Currency, time.Time(total.Date))
+			}
+		}
+	}
+
+	type expenseTotal struct {
+		ProjectID      uuid.UUID
+		Currency       string
+		Category       models.ExpenseCategory
+		Date           datatypes.Date
+		Amount         float64
+		BillableAmount float64
+	}
+
+	var expenses []expenseTotal
+	query = database.DB.Model(&models.Expense{}).
+		Select("project_id, currency, category, date, SUM(amount) AS amount, SUM(CASE WHEN is_billable THEN amount + amount * markup_percent / 100 ELSE 0 END) AS billable_amount").
+		Where("user_id = ? AND project_id IN ?", userID, projectIDs)
+	query = s.applyDateRange(query, startDate, endDate)
+	if err := query.Group("project_id, currency, category, date").Order("currency ASC").Scan(&expenses).Error; err != nil {
+		return nil, err
+	}
+
+	for _, total := range expenses {
+		report := byID[total.ProjectID]
+		addition := &ExpenseTotal{
+			Currency:       total.Currency,
+			Amount:         total.Amount,
+			BillableAmount: total.BillableAmount,
+			ByCategory:     map[models.ExpenseCategory]float64{total.Category: total.Amount},
+		}
+		if converter != nil {
+			addition.ConvertedAmount = report.Converted.convert(converter, total.Amount, total.Currency, time.Time(total.Date))
+			addition.ConvertedBillableAmount = report.Converted.convert(converter, total.BillableAmount, total.Currency, time.Time(total.Date))
+			report.Converted.ExpenseAmount += addition.ConvertedAmount
+			report.Converted.BillableExpenseAmount += addition.ConvertedBillableAmount
+		}
+		report.Expenses = mergeExpenseTotals(report.Expenses, []*ExpenseTotal{addition})
+	}
+
+	type travelTotal struct {
+		ProjectID      uuid.UUID
+		Currency       string
+		Date           datatypes.Date
+		Count          int
+		Quantity       float64
+		Amount         float64
+		BillableAmount float64
+	}
+
+	var mileage []travelTotal
+	query = database.DB.Model(&models.MileageEntry{}).
+		Select("project_id, currency, date, COUNT(*) AS count, SUM(distance) AS quantity, SUM(amount) AS amount, SUM(CASE WHEN is_billable THEN amount ELSE 0 END) AS billable_amount").
+		Where("user_id = ? AND project_id IN ?", userID, projectIDs)
+	query = s.applyDateRange(query, startDate, endDate)
+	if err := query.Group("project_id, currency, date").Scan(&mileage).Error; err != nil {
+		return nil, err
+	}
+
+	for _, total := range mileage {
+		report := byID[total.ProjectID]
+		report.Mileage.add(total.Count, total.Quantity, total.Amount, total.BillableAmount)
+		if converter != nil {
+			report.Converted.addTravel(converter, total.Amount, total.BillableAmount, total.Currency, time.Time(total.Date))
+		}
+	}
+
+	var perDiems []travelTotal
+	query = database.DB.Model(&models.PerDiemClaim{}).
+		Select("project_id, currency, date, COUNT(*) AS count, SUM(days) AS quantity, SUM(amount) AS amount, SUM(CASE WHEN is_billable THEN amount ELSE 0 END) AS billable_amount").
+		Where("user_id = ? AND project_id IN ?", userID, projectIDs)
+	query = s.applyDateRange(query, startDate, endDate)
+	if err := query.Group("project_id, currency, date").Scan(&perDiems).Error; err != nil {
+		return nil, err
+	}
+
+	for _, total := range perDiems {
+		report := byID[total.ProjectID]
+		report.PerDiem.add(total.Count, total.Quantity, total.Amount, total.BillableAmount)
+		if converter != nil {
+			report.Converted.addTravel(converter, total.Amount, total.BillableAmount, total.Currency, time.Time(total.Date))
+		}
+	}
+
+	return reports, nil
+}
+
+func (t *TravelTotal) add(count int, quantity, amount, billableAmount float64) {
+	t.Count += count
+	t.Quantity += quantity
+	t.Amount += amount
+	t.BillableAmount += billableAmount
+}
+
+func (t *ConvertedTotals) addTravel(converter *currencyConverter, amount, billableAmount float64, currency string, date time.Time) {
+	t.TravelAmount += t.convert(converter, amount, currency, date)
+	t.BillableTravelAmount += t.convert(converter, billableAmount, currency, date)
+}
+
+func (s *ReportService) applyDateRange(query *gorm.DB, startDate, endDate *time.Time) *gorm.DB {
+	if startDate != nil {
+		query = query.Where("date >= ?", datatypes.Date(*startDate))
+	}
+	if endDate != nil {
+		query = query.Where("date <= ?", datatypes.Date(*endDate))
+	}
+	return query
+}
+
+func mergeExpenseTotals(totals []*ExpenseTotal, additions []*ExpenseTotal) []*ExpenseTotal {
+	for _, addition := range additions {
+		var target *ExpenseTotal
+		for _, total := range totals {
+			if total.Currency == addition.Currency {
+				target = total
+				break
+			}
+		}
+
+		if target == nil {
+			target = &ExpenseTotal{
+				Currency:   addition.Currency,
+				ByCategory: make(map[models.ExpenseCategory]float64),
+			}
+			totals = append(totals, target)
+		}
+
+		target.Amount += addition.Amount
+		target.BillableAmount += addition.BillableAmount
+		target.ConvertedAmount += addition.ConvertedAmount
+		target.ConvertedBillableAmount += addition.ConvertedBillableAmount
+		for category, amount := range addition.ByCategory {
+			target.ByCategory[category] += amount
+		}
+	}
+	return totals
+}
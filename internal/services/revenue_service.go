@@ -0,0 +1,412 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type RevenueService struct{}
+
+func NewRevenueService() *RevenueService {
+	return &RevenueService{}
+}
+
+var (
+	ErrInvalidRevenueGrouping = errors.New("group_by takes client, project or consultant, or a comma-separated combination of them")
+	ErrInvalidRevenuePeriod   = errors.New("invalid revenue period, use week, month or quarter")
+)
+
+type RevenueGrouping string
+
+const (
+	RevenueGroupingClient     RevenueGrouping = "client"
+	RevenueGroupingProject    RevenueGrouping = "project"
+	RevenueGroupingConsultant RevenueGrouping = "consultant"
+)
+
+type RevenuePeriod string
+
+const (
+	RevenuePeriodWeek    RevenuePeriod = "week"
+	RevenuePeriodMonth   RevenuePeriod = "month"
+	RevenuePeriodQuarter RevenuePeriod = "quarter"
+)
+
+type RevenueBucket struct {
+	StartDate       time.Time
+	EndDate         time.Time
+	Hours           float64
+	Amount          float64
+	ConvertedAmount float64
+}
+
+type RevenueRow struct {
+	ClientID                uuid.UUID
+	ProjectID               uuid.UUID
+	UserID                  uuid.UUID
+	Client                  *models.Client
+	Project                 *models.Project
+	User                    *models.User
+	Currency                string
+	Hours                   float64
+	Amount                  float64
+	PreviousHours           float64
+	PreviousAmount          float64
+	ConvertedAmount         float64
+	ConvertedPreviousAmount float64
+	Periods                 []*RevenueBucket
+}
+
+type RevenueTotal struct {
+	Currency       string
+	Hours          float64
+	Amount         float64
+	PreviousHours  float64
+	PreviousAmount float64
+}
+
+type RevenuePeriodTotal struct {
+	StartDate       time.Time
+	EndDate         time.Time
+	Hours           float64
+	Amounts         map[string]float64
+	ConvertedAmount float64
+}
+
+type RevenueReport struct {
+	StartDate         time.Time
+	EndDate           time.Time
+	PreviousStartDate time.Time
+	PreviousEndDate   time.Time
+	GroupBy           []RevenueGrouping
+	Period            RevenuePeriod
+	Rows              []*RevenueRow
+	Periods           []*RevenuePeriodTotal
+	Totals            []*RevenueTotal
+	Converted         *ConvertedTotals
+	ConvertedPrevious float64
+}
+
+type revenueTotal struct {
+	ClientID    uuid.UUID
+	ProjectID   uuid.UUID
+	UserID      uuid.UUID
+	Currency    string
+	PeriodStart string
+	Hours       float64
+	Amount      float64
+}
+
+func (s *RevenueService) GetRevenueReport(userID uuid.UUID, startDate, endDate time.Time, groupBy []RevenueGrouping, period RevenuePeriod, currency string) (*RevenueReport, error) {
+	groupColumns, err := s.groupColumns(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	periodColumn, err := s.periodColumn(period)
+	if err != nil {
+		return nil, err
+	}
+
+	converter, err := NewReportService().loadConverter(userID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStart, previousEnd := s.previousRange(startDate, endDate)
+	report := &RevenueReport{
+		StartDate:         startDate,
+		EndDate:           endDate,
+		PreviousStartDate: previousStart,
+		PreviousEndDate:   previousEnd,
+		GroupBy:           groupBy,
+		Period:            period,
+	}
+	if converter != nil {
+		report.Converted = &ConvertedTotals{Currency: converter.target}
+	}
+
+	for start := s.periodStart(startDate, period); !start.After(endDate); start = s.nextPeriod(start, period) {
+		bucket := &RevenuePeriodTotal{
+			StartDate: start,
+			EndDate:   s.nextPeriod(start, period).AddDate(0, 0, -1),
+			Amounts:   make(map[string]float64),
+		}
+		if bucket.StartDate.Before(startDate) {
+			bucket.StartDate = startDate
+		}
+		if bucket.EndDate.After(endDate) {
+			bucket.EndDate = endDate
+		}
+		report.Periods = append(report.Periods, bucket)
+	}
+
+	current, err := s.aggregate(userID, startDate, endDate, groupColumns, periodColumn)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.aggregate(userID, previousStart, previousEnd, groupColumns, periodColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	type rowKey struct {
+		ClientID  uuid.UUID
+		ProjectID uuid.UUID
+		UserID    uuid.UUID
+		Currency  string
+	}
+
+	rows := make(map[rowKey]*RevenueRow)
+	totals := make(map[string]*RevenueTotal)
+	rowFor := func(total revenueTotal) *RevenueRow {
+		key := rowKey{ClientID: total.ClientID, ProjectID: total.ProjectID, UserID: total.UserID, Currency: total.Currency}
+		if _, exists := rows[key]; !exists {
+			rows[key] = &RevenueRow{ClientID: total.ClientID, ProjectID: total.ProjectID, UserID: total.UserID, Currency: total.Currency}
+			for _, bucket := range report.Periods {
+				rows[key].Periods = append(rows[key].Periods, &RevenueBucket{StartDate: bucket.StartDate, EndDate: bucket.EndDate})
+			}
+		}
+		if _, exists := totals[total.Currency]; !exists {
+			totals[total.Currency] = &RevenueTotal{Currency: total.Currency}
+		}
+		return rows[key]
+	}
+
+	for _, total := range current {
+		periodStart, err := time.Parse("2006-01-02", total.PeriodStart)
+		if err != nil {
+			return nil, err
+		}
+		revenueRow := rowFor(total)
+		revenueRow.Hours += total.Hours
+		revenueRow.Amount += total.Amount
+		totals[total.Currency].Hours += total.Hours
+		totals[total.Currency].Amount += total.Amount
+
+		var converted float64
+		if converter != nil {
+			converted = report.Converted.convert(converter, total.Amount, total.Currency, periodStart)
+			revenueRow.ConvertedAmount += converted
+			report.Converted.BillableAmount += converted
+		}
+
+		for i, bucket := range report.Periods {
+			if periodStart.After(bucket.EndDate) {
+				continue
+			}
+			revenueRow.Periods[i].Hours += total.Hours
+			revenueRow.Periods[i].Amount += total.Amount
+			revenueRow.Periods[i].ConvertedAmount += converted
+			bucket.Hours += total.Hours
+			bucket.Amounts[total.Currency] += total.Amount
+			bucket.ConvertedAmount += converted
+			break
+		}
+	}
+
+	for _, total := range previous {
+		periodStart, err := time.Parse("2006-01-02", total.PeriodStart)
+		if err != nil {
+			return nil, err
+		}
+		revenueRow := rowFor(total)
+		revenueRow.PreviousHours += total.Hours
+		revenueRow.PreviousAmount += total.Amount
+		totals[total.Currency].PreviousHours += total.Hours
+		totals[total.Currency].PreviousAmount += total.Amount
+
+		if converter != nil {
+			converted := report.Converted.convert(converter, total.Amount, total.Currency, periodStart)
+			revenueRow.ConvertedPreviousAmount += converted
+			report.ConvertedPrevious += converted
+		}
+	}
+
+	for _, revenueRow := range rows {
+		report.Rows = append(report.Rows, revenueRow)
+	}
+	if err := s.loadGroups(groupBy, report.Rows); err != nil {
+		return nil, err
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, total)
+	}
+
+	sort.Slice(report.Rows, func(i, j int) bool {
+		for _, grouping := range groupBy {
+			left, right := report.Rows[i].Label(grouping), report.Rows[j].Label(grouping)
+			if left != right {
+				return left < right
+			}
+		}
+		return report.Rows[i].Currency < report.Rows[j].Currency
+	})
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].Currency < report.Totals[j].Currency
+	})
+
+	return report, nil
+}
+
+func (r *RevenueRow) Label(grouping RevenueGrouping) string {
+	switch {
+	case grouping == RevenueGroupingClient && r.Client != nil:
+		return strings.ToLower(r.Client.Name)
+	case grouping == RevenueGroupingProject && r.Project != nil:
+		return strings.ToLower(r.Project.Name)
+	case grouping == RevenueGroupingConsultant && r.User != nil:
+		return strings.ToLower(r.User.Username)
+	}
+	return ""
+}
+
+func (s *RevenueService) aggregate(userID uuid.UUID, startDate, endDate time.Time, groupColumns []string, periodColumn string) ([]revenueTotal, error) {
+	var totals []revenueTotal
+	err := database.DB.Model(&models.TimeEntry{}).
+		Select(strings.Join(groupColumns, ", ")+", projects.currency, "+periodColumn+" AS period_start, "+
+			"SUM(time_entries.hours - time_entries.written_down_hours) AS hours, "+
+			"SUM((time_entries.hours - time_entries.written_down_hours) * "+effectiveRateSQL+") AS amount").
+		Joins("JOIN projects ON projects.id = time_entries.project_id").
+		Joins("JOIN clients ON clients.id = projects.client_id").
+		Where("projects.user_id = ? AND time_entries.is_billable = ?", userID, true).
+		Where("time_entries.date >= ? AND time_entries.date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+		Group(strings.Join(groupColumns, ", ") + ", projects.currency, period_start").
+		Scan(&totals).Error
+	return totals, err
+}
+
+func (s *RevenueService) groupColumns(groupBy []RevenueGrouping) ([]string, error) {
+	if len(groupBy) == 0 {
+		return nil, ErrInvalidRevenueGrouping
+	}
+
+	var columns []string
+	seen := make(map[RevenueGrouping]bool)
+	for _, grouping := range groupBy {
+		if seen[grouping] {
+			return nil, ErrInvalidRevenueGrouping
+		}
+		seen[grouping] = true
+
+		switch grouping {
+		case RevenueGroupingClient:
+			columns = append(columns, "projects.client_id")
+		case RevenueGroupingProject:
+			columns = append(columns, "time_entries.project_id")
+		case RevenueGroupingConsultant:
+			columns = append(columns, "time_entries.user_id")
+		default:
+			return nil, ErrInvalidRevenueGrouping
+		}
+	}
+	return columns, nil
+}
+
+func (s *RevenueService) periodColumn(period RevenuePeriod) (string, error) {
+	switch period {
+	case RevenuePeriodWeek:
+		return "date(time_entries.date, '-6 days', 'weekday 1')", nil
+	case RevenuePeriodMonth:
+		return "strftime('%Y-%m-01', time_entries.date)", nil
+	case RevenuePeriodQuarter:
+		return "printf('%s-%02d-01', strftime('%Y', time_entries.date), (CAST(strftime('%m', time_entries.date) AS INTEGER) - 1) / 3 * 3 + 1)", nil
+	}
+	return "", ErrInvalidRevenuePeriod
+}
+
+func (s *RevenueService) periodStart(date time.Time, period RevenuePeriod) time.Time {
+	switch period {
+	case RevenuePeriodMonth:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case RevenuePeriodQuarter:
+		return time.Date(date.Year(), (date.Month()-1)/3*3+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return startOfWeek(date)
+}
+
+func (s *RevenueService) nextPeriod(start time.Time, period RevenuePeriod) time.Time {
+	switch period {
+	case RevenuePeriodMonth:
+		return start.AddDate(0, 1, 0)
+	case RevenuePeriodQuarter:
+		return start.AddDate(0, 3, 0)
+	}
+	return start.AddDate(0, 0, 7)
+}
+
+func (s *RevenueService) previousRange(startDate, endDate time.Time) (time.Time, time.Time) {
+	if startDate.Day() == 1 && endDate.AddDate(0, 0, 1).Day() == 1 {
+		months := (endDate.Year()-startDate.Year())*12 + int(endDate.Month()-startDate.Month()) + 1
+		return startDate.AddDate(0, -months, 0), startDate.AddDate(0, 0, -1)
+	}
+	days := int(endDate.Sub(startDate).Hours()/24) + 1
+	return startDate.AddDate(0, 0, -days), startDate.AddDate(0, 0, -1)
+}
+
+func (s *RevenueService) loadGroups(groupBy []RevenueGrouping, rows []*RevenueRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	for _, grouping := range groupBy {
+		switch grouping {
+		case RevenueGroupingClient:
+			ids := make([]uuid.UUID, len(rows))
+			for i, row := range rows {
+				ids[i] = row.ClientID
+			}
+			var clients []*models.Client
+			if err := database.DB.Unscoped().Where("id IN ?", ids).Find(&clients).Error; err != nil {
+				return err
+			}
+			byID := make(map[uuid.UUID]*models.Client, len(clients))
+			for _, client := range clients {
+				byID[client.ID] = client
+			}
+			for _, row := range rows {
+				row.Client = byID[row.ClientID]
+			}
+		case RevenueGroupingProject:
+			ids := make([]uuid.UUID, len(rows))
+			for i, row := range rows {
+				ids[i] = row.ProjectID
+			}
+			var projects []*models.Project
+			if err := database.DB.Unscoped().Preload("Client").Where("id IN ?", ids).Find(&projects).Error; err != nil {
+				return err
+			}
+			byID := make(map[uuid.UUID]*models.Project, len(projects))
+			for _, project := range projects {
+				byID[project.ID] = project
+			}
+			for _, row := range rows {
+				row.Project = byID[row.ProjectID]
+			}
+		case RevenueGroupingConsultant:
+			ids := make([]uuid.UUID, len(rows))
+			for i, row := range rows {
+				ids[i] = row.UserID
+			}
+			var users []*models.User
+			if err := database.DB.Where("id IN ?", ids).Find(&users).Error; err != nil {
+				return err
+			}
+			byID := make(map[uuid.UUID]*models.User, len(users))
+			for _, user := range users {
+				byID[user.ID] = user
+			}
+			for _, row := range rows {
+				row.User = byID[row.UserID]
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type RevisionService struct{}
+
+func NewRevisionService() *RevisionService {
+	return &RevisionService{}
+}
+
+func (s *RevisionService) ListRevisions(entityType string, entityID uuid.UUID) ([]*models.Revision, error) {
+	var revisions []*models.Revision
+	err := database.DB.Preload("User").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("created_at ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+func (s *RevisionService) ListOverrides(offset, limit int) ([]*models.Revision, int64, error) {
+	var revisions []*models.Revision
+	var total int64
+
+	query := database.DB.Model(&models.Revision{}).Where("override = ?", true)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("User").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&revisions).Error
+	return revisions, total, err
+}
+
+func recordRevision(tx *gorm.DB, entityType string, entityID, userID uuid.UUID, action models.RevisionAction, before, after map[string]interface{}) error {
+	return createRevision(tx, &models.Revision{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		UserID:     userID,
+	}, before, after)
+}
+
+func recordOverrideRevision(tx *gorm.DB, entityType string, entityID, userID uuid.UUID, action models.RevisionAction, before, after map[string]interface{}, reason string) error {
+	return createRevision(tx, &models.Revision{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		UserID:     userID,
+		Override:   true,
+		Reason:     reason,
+	}, before, after)
+}
+
+func createRevision(tx *gorm.DB, revision *models.Revision, before, after map[string]interface{}) error {
+
+	if before != nil {
+		data, err := json.Marshal(before)
+		if err != nil {
+			return err
+		}
+		revision.Before = datatypes.JSON(data)
+	}
+
+	if after != nil {
+		data, err := json.Marshal(after)
+		if err != nil {
+			return err
+		}
+		revision.After = datatypes.JSON(data)
+	}
+
+	return tx.Create(revision).Error
+}
+
+func timeEntrySnapshot(entry *models.TimeEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id":  entry.ProjectID,
+		"date":        time.Time(entry.Date).Format("2006-01-02"),
+		"hours":       entry.Hours,
+		"description": entry.Description,
+		"is_billable": entry.IsBillable,
+	}
+}
+
+func allocationSnapshot(allocation *models.Allocation) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id":    allocation.ProjectID,
+		"week_starting": allocation.WeekStarting.Format("2006-01-02"),
+		"hours":         allocation.Hours,
+		"notes":         allocation.Notes,
+	}
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	SequenceInvoice    = "invoice"
+	SequenceCreditNote = "credit_note"
+)
+
+func nextSequenceValue(tx *gorm.DB, userID uuid.UUID, name string) (int, error) {
+	result := tx.Model(&models.NumberSequence{}).
+		Where("user_id = ? AND name = ?", userID, name).
+		Update("last_value", gorm.Expr("last_value + 1"))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		sequence := &models.NumberSequence{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Name:      name,
+			LastValue: 1,
+		}
+		if err := tx.Create(sequence).Error; err != nil {
+			return 0, err
+		}
+		return sequence.LastValue, nil
+	}
+
+	var sequence models.NumberSequence
+	if err := tx.Where("user_id = ? AND name = ?", userID, name).First(&sequence).Error; err != nil {
+		return 0, err
+	}
+	return sequence.LastValue, nil
+}
+
+func formatSequenceNumber(prefix string, value int) string {
+	return fmt.Sprintf("%s-%06d", prefix, value)
+}
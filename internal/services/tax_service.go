@@ -0,0 +1,196 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type TaxService struct{}
+
+func NewTaxService() *TaxService {
+	return &TaxService{}
+}
+
+var (
+	ErrTaxRateNotFound    = errors.New("no tax rate configured for the client's country")
+	ErrInvalidTaxStatus   = errors.New("invalid tax status")
+	ErrTaxIDRequired      = errors.New("reverse-charge clients need a tax ID")
+	ErrTaxCountryRequired = errors.New("exempt and reverse-charge clients need a country")
+)
+
+const reverseChargeNote = "Reverse charge: VAT to be accounted for by the recipient."
+
+type TaxProfile struct {
+	TaxID   string
+	Country string
+	Status  models.TaxStatus
+}
+
+func (p TaxProfile) normalize() (TaxProfile, error) {
+	p.TaxID = strings.TrimSpace(p.TaxID)
+	p.Country = strings.ToUpper(strings.TrimSpace(p.Country))
+	if p.Status == "" {
+		p.Status = models.TaxStatusStandard
+	}
+	return p, p.validate()
+}
+
+func (p TaxProfile) validate() error {
+	switch p.Status {
+	case models.TaxStatusStandard:
+	case models.TaxStatusExempt, models.TaxStatusReverseCharge:
+		if p.Country == "" {
+			return ErrTaxCountryRequired
+		}
+	default:
+		return ErrInvalidTaxStatus
+	}
+	if p.Status == models.TaxStatusReverseCharge && p.TaxID == "" {
+		return ErrTaxIDRequired
+	}
+	return nil
+}
+
+func clientTaxProfile(client *models.Client) TaxProfile {
+	return TaxProfile{TaxID: client.TaxID, Country: client.Country, Status: client.TaxStatus}
+}
+
+func (s *TaxService) CreateRate(country, name string, rate float64, effectiveFrom time.Time) (*models.TaxRate, error) {
+	taxRate := &models.TaxRate{
+		Country:       strings.ToUpper(country),
+		Name:          name,
+		Rate:          rate,
+		EffectiveFrom: datatypes.Date(effectiveFrom),
+	}
+	if err := database.DB.Create(taxRate).Error; err != nil {
+		return nil, err
+	}
+	return taxRate, nil
+}
+
+func (s *TaxService) ListRates(country *string) ([]*models.TaxRate, error) {
+	var rates []*models.TaxRate
+
+	query := database.DB.Model(&models.TaxRate{})
+	if country != nil {
+		query = query.Where("country = ?", strings.ToUpper(*country))
+	}
+
+	err := query.Order("country ASC, effective_from DESC").Find(&rates).Error
+	return rates, err
+}
+
+func (s *TaxService) DeleteRate(rateID uuid.UUID) error {
+	result := database.DB.Unscoped().Where("id = ?", rateID).Delete(&models.TaxRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaxRateNotFound
+	}
+	return nil
+}
+
+func (s *TaxService) ResolveRate(country string, date time.Time) (*models.TaxRate, error) {
+	var rate models.TaxRate
+	err := database.DB.
+		Where("country = ? AND effective_from <= ?", strings.ToUpper(country), datatypes.Date(date)).
+		Order("effective_from DESC, created_at DESC").
+		First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTaxRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+type TaxJurisdictionTotal struct {
+	Country       string
+	Treatment     models.TaxStatus
+	Currency      string
+	Rate          float64
+	InvoiceCount  int
+	TaxableAmount float64
+	TaxAmount     float64
+}
+
+func (s *TaxService) GetTaxReport(userID uuid.UUID, startDate, endDate time.Time) ([]*TaxJurisdictionTotal, error) {
+	var totals []*TaxJurisdictionTotal
+	err := database.DB.Model(&models.InvoiceTaxLine{}).
+		Select("invoice_tax_lines.country, invoice_tax_lines.treatment, invoices.currency, invoice_tax_lines.rate, COUNT(DISTINCT invoices.id) AS invoice_count, SUM(invoice_tax_lines.taxable_amount) AS taxable_amount, SUM(invoice_tax_lines.amount) AS tax_amount").
+		Joins("JOIN invoices ON invoices.id = invoice_tax_lines.invoice_id AND invoices.deleted_at IS NULL").
+		Where("invoices.user_id = ? AND invoices.status IN ?", userID, []models.InvoiceStatus{models.InvoiceStatusIssued, models.InvoiceStatusPaid}).
+		Where("invoices.issue_date >= ? AND invoices.issue_date <= ?", datatypes.Date(startDate), datatypes.Date(endDate)).
+		Group("invoice_tax_lines.country, invoice_tax_lines.treatment, invoices.currency, invoice_tax_lines.rate").
+		Order("invoice_tax_lines.country ASC, invoice_tax_lines.treatment ASC, invoices.currency ASC, invoice_tax_lines.rate DESC").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+func (s *TaxService) applyInvoiceTax(tx *gorm.DB, invoice *models.Invoice, client *models.Client, date time.Time) error {
+	if err := tx.Unscoped().Where("invoice_id = ?", invoice.ID).Delete(&models.InvoiceTaxLine{}).Error; err != nil {
+		return err
+	}
+
+	invoice.TaxLines = nil
+	invoice.TaxTotal = 0
+	invoice.ClientTaxID = client.TaxID
+	invoice.TaxNote = ""
+
+	line := &models.InvoiceTaxLine{
+		InvoiceID:     invoice.ID,
+		Country:       client.Country,
+		Treatment:     client.TaxStatus,
+		TaxableAmount: invoice.Subtotal,
+	}
+
+	switch client.TaxStatus {
+	case models.TaxStatusExempt:
+		line.Name = "Exempt"
+		invoice.TaxNote = "Exempt from tax."
+	case models.TaxStatusReverseCharge:
+		line.Name = "Reverse charge"
+		invoice.TaxNote = reverseChargeNote
+	default:
+		if client.Country == "" {
+			line = nil
+			break
+		}
+		rate, err := s.ResolveRate(client.Country, date)
+		if err != nil {
+			return err
+		}
+		line.Name = rate.Name
+		line.Rate = rate.Rate
+		line.Amount = math.Round(invoice.Subtotal*rate.Rate) / 100
+	}
+
+	if line != nil {
+		if err := tx.Create(line).Error; err != nil {
+			return err
+		}
+		invoice.TaxLines = []models.InvoiceTaxLine{*line}
+		invoice.TaxTotal = line.Amount
+	}
+	invoice.Total = invoice.Subtotal + invoice.TaxTotal
+
+	return tx.Model(invoice).Updates(map[string]interface{}{
+		"tax_total":     invoice.TaxTotal,
+		"total":         invoice.Total,
+		"client_tax_id": invoice.ClientTaxID,
+		"tax_note":      invoice.TaxNote,
+	}).Error
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
@@ -23,14 +24,14 @@ var (
 	ErrExceedsAllocation = errors.New("time entry exceeds weekly allocation")
 	ErrNoAllocation      = errors.New("no allocation found for this week")
 	ErrDateInFuture      = errors.New("cannot log time for future dates")
+
+	ErrTimeEntryLocked        = errors.New("time entry is locked by an invoice")
+	ErrOverrideNotPermitted   = errors.New("only administrators can override invoice locks")
+	ErrOverrideReasonRequired = errors.New("a reason is required to override an invoice lock")
 )
 
-func (s *TimeEntryService) getWeekStart(date time.Time) time.Time {
-	weekday := int(date.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	return date.AddDate(0, 0, -(weekday - 1)).Truncate(24 * time.Hour)
+type LockOverride struct {
+	Reason string
 }
 
 func (s *TimeEntryService) CreateTimeEntry(userID, projectID uuid.UUID, date time.Time, hours float64, description string, isBillable bool) (*models.TimeEntry, error) {
@@ -38,13 +39,13 @@ func (s *TimeEntryService) CreateTimeEntry(userID, projectID uuid.UUID, date tim
 		return nil, ErrDateInFuture
 	}
 
-	var project models.Project
-	if err := database.DB.Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+	_, err := memberProject(userID, projectID)
+	if err != nil {
 		return nil, errors.New("project not found or access denied")
 	}
 
 	var existing models.TimeEntry
-	err := database.DB.Where("project_id = ? AND user_id = ? AND date = ?",
+	err = database.DB.Where("project_id = ? AND user_id = ? AND date = ?",
 		projectID, userID, datatypes.Date(date)).First(&existing).Error
 	if err == nil {
 		return nil, ErrTimeEntryExists
@@ -59,7 +60,18 @@ func (s *TimeEntryService) CreateTimeEntry(userID, projectID uuid.UUID, date tim
 		IsBillable:  isBillable,
 	}
 
-	if err := database.DB.Create(timeEntry).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(timeEntry).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityTimeEntry, timeEntry.ID, userID,
+			models.RevisionActionCreate, nil, timeEntrySnapshot(timeEntry))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBudgets(projectID); err != nil {
 		return nil, err
 	}
 
@@ -134,7 +146,7 @@ func (s *TimeEntryService) GetDayEntries(userID uuid.UUID, date time.Time) ([]*m
 }
 
 func (s *TimeEntryService) GetWeekEntries(userID uuid.UUID, weekStarting time.Time) ([]*models.TimeEntry, map[string]float64, error) {
-	weekStart := s.getWeekStart(weekStarting)
+	weekStart := startOfWeek(weekStarting)
 	weekEnd := weekStart.AddDate(0, 0, 6)
 
 	var entries []*models.TimeEntry
@@ -155,46 +167,167 @@ func (s *TimeEntryService) GetWeekEntries(userID uuid.UUID, weekStarting time.Ti
 	return entries, dailyTotals, nil
 }
 
-func (s *TimeEntryService) UpdateTimeEntry(userID, timeEntryID uuid.UUID, hours float64, description string, isBillable bool) (*models.TimeEntry, error) {
-	var timeEntry models.TimeEntry
+func (s *TimeEntryService) checkBudgets(projectID uuid.UUID) error {
+	var project models.Project
+	if err := database.DB.Unscoped().Select("id, user_id").First(&project, "id = ?", projectID).Error; err != nil {
+		return err
+	}
+	return NewBudgetService().CheckThresholds(project.UserID, projectID)
+}
 
-	if err := database.DB.Where("id = ? AND user_id = ?", timeEntryID, userID).First(&timeEntry).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrTimeEntryNotFound
-		}
+func (s *TimeEntryService) UpdateTimeEntry(userID, timeEntryID uuid.UUID, hours float64, description string, isBillable *bool, override *LockOverride) (*models.TimeEntry, error) {
+	timeEntry, err := s.findForChange(userID, timeEntryID, override)
+	if err != nil {
 		return nil, err
 	}
 
+	if err := s.checkLock(userID, timeEntry, override); err != nil {
+		return nil, err
+	}
+
+	if hours < timeEntry.WrittenDownHours {
+		return nil, ErrWriteDownExceedsHours
+	}
+
+	before := timeEntrySnapshot(timeEntry)
+
 	updates := map[string]interface{}{
 		"hours":       hours,
 		"description": description,
-		"is_billable": isBillable,
+	}
+	if isBillable != nil {
+		updates["is_billable"] = *isBillable
 	}
 
-	if err := database.DB.Model(&timeEntry).Updates(updates).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(timeEntry).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		timeEntry.Hours = hours
+		timeEntry.Description = description
+		if isBillable != nil {
+			timeEntry.IsBillable = *isBillable
+		}
+
+		return s.recordLockedRevision(tx, timeEntry, userID, models.RevisionActionUpdate,
+			before, timeEntrySnapshot(timeEntry), override)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := database.DB.Preload("Project.Client").First(&timeEntry, timeEntry.ID).Error; err != nil {
+	if err := s.checkBudgets(timeEntry.ProjectID); err != nil {
 		return nil, err
 	}
 
+	if err := database.DB.Preload("Project.Client").First(timeEntry, timeEntry.ID).Error; err != nil {
+		return nil, err
+	}
+
+	return timeEntry, nil
+}
+
+func (s *TimeEntryService) DeleteTimeEntry(userID, timeEntryID uuid.UUID, override *LockOverride) error {
+	timeEntry, err := s.findForChange(userID, timeEntryID, override)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkLock(userID, timeEntry, override); err != nil {
+		return err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(timeEntry).Error; err != nil {
+			return err
+		}
+		return s.recordLockedRevision(tx, timeEntry, userID, models.RevisionActionDelete,
+			timeEntrySnapshot(timeEntry), nil, override)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.checkBudgets(timeEntry.ProjectID)
+}
+
+func (s *TimeEntryService) findForChange(userID, timeEntryID uuid.UUID, override *LockOverride) (*models.TimeEntry, error) {
+	ownOnly := true
+	if override != nil {
+		isAdmin, err := s.isAdmin(userID)
+		if err != nil {
+			return nil, err
+		}
+		ownOnly = !isAdmin
+	}
+
+	query := database.DB.Where("id = ?", timeEntryID)
+	if ownOnly {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	var timeEntry models.TimeEntry
+	if err := query.First(&timeEntry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
 	return &timeEntry, nil
 }
 
-func (s *TimeEntryService) DeleteTimeEntry(userID, timeEntryID uuid.UUID) error {
-	result := database.DB.Where("id = ? AND user_id = ?", timeEntryID, userID).Delete(&models.TimeEntry{})
-	if result.Error != nil {
-		return result.Error
+func (s *TimeEntryService) isAdmin(userID uuid.UUID) (bool, error) {
+	var user models.User
+	if err := database.DB.Select("id, is_admin").Where("id = ?", userID).First(&user).Error; err != nil {
+		return false, err
+	}
+	return user.IsAdmin, nil
+}
+
+func (s *TimeEntryService) checkLock(userID uuid.UUID, timeEntry *models.TimeEntry, override *LockOverride) error {
+	if !timeEntry.IsLocked() {
+		return nil
 	}
-	if result.RowsAffected == 0 {
-		return ErrTimeEntryNotFound
+	if override == nil {
+		return ErrTimeEntryLocked
+	}
+	if strings.TrimSpace(override.Reason) == "" {
+		return ErrOverrideReasonRequired
+	}
+
+	isAdmin, err := s.isAdmin(userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrOverrideNotPermitted
 	}
 	return nil
 }
 
+func (s *TimeEntryService) recordLockedRevision(tx *gorm.DB, timeEntry *models.TimeEntry, userID uuid.UUID, action models.RevisionAction, before, after map[string]interface{}, override *LockOverride) error {
+	if timeEntry.IsLocked() && override != nil {
+		return recordOverrideRevision(tx, models.RevisionEntityTimeEntry, timeEntry.ID, userID,
+			action, before, after, strings.TrimSpace(override.Reason))
+	}
+	return recordRevision(tx, models.RevisionEntityTimeEntry, timeEntry.ID, userID, action, before, after)
+}
+
+func (s *TimeEntryService) GetTimeEntryHistory(userID, timeEntryID uuid.UUID) ([]*models.Revision, error) {
+	var timeEntry models.TimeEntry
+	if err := database.DB.Unscoped().Where("id = ? AND user_id = ?", timeEntryID, userID).First(&timeEntry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	return NewRevisionService().ListRevisions(models.RevisionEntityTimeEntry, timeEntry.ID)
+}
+
 func (s *TimeEntryService) GetProjectWeekComparison(userID, projectID uuid.UUID, weekStarting time.Time) (float64, float64, error) {
-	weekStart := s.getWeekStart(weekStarting)
+	weekStart := startOfWeek(weekStarting)
 	weekEnd := weekStart.AddDate(0, 0, 6)
 
 	var allocation models.Allocation
@@ -216,8 +349,25 @@ func (s *TimeEntryService) GetProjectWeekComparison(userID, projectID uuid.UUID,
 	return allocatedHours, actualHours, err
 }
 
+func (s *TimeEntryService) GetWeekOvertime(userID uuid.UUID, weekStarting time.Time) (*OvertimeResult, []*ProjectBilling, error) {
+	weekStart := startOfWeek(weekStarting)
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	overtime, err := NewOvertimeService().CalculateOvertime(userID, weekStart, weekEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	billing, err := NewBillingService().CalculateBilling(userID, weekStart, weekEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return overtime, billing, nil
+}
+
 func (s *TimeEntryService) GetWeekSummary(userID uuid.UUID, weekStarting time.Time) (map[uuid.UUID]map[string]float64, error) {
-	weekStart := s.getWeekStart(weekStarting)
+	weekStart := startOfWeek(weekStarting)
 	weekEnd := weekStart.AddDate(0, 0, 6)
 
 	type ProjectWeekData struct {
@@ -254,3 +404,231 @@ func (s *TimeEntryService) GetWeekSummary(userID uuid.UUID, weekStarting time.Ti
 
 	return summary, nil
 }
+
+type MonthPeriod struct {
+	StartDate time.Time
+	EndDate   time.Time
+	TimeZone  string
+}
+
+func userLocation(userID uuid.UUID) (*time.Location, error) {
+	var user models.User
+	if err := database.DB.Select("time_zone").Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, err
+	}
+	if user.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(user.TimeZone)
+}
+
+func (s *TimeEntryService) ResolveMonth(userID uuid.UUID, month *time.Time) (*MonthPeriod, error) {
+	location, err := userLocation(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reference := time.Now().In(location)
+	if month != nil {
+		reference = *month
+	}
+
+	start := time.Date(reference.Year(), reference.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return &MonthPeriod{
+		StartDate: start,
+		EndDate:   start.AddDate(0, 1, -1),
+		TimeZone:  location.String(),
+	}, nil
+}
+
+func (s *TimeEntryService) GetMonthEntries(userID uuid.UUID, period *MonthPeriod) ([]*models.TimeEntry, map[string]float64, map[string]float64, error) {
+	var entries []*models.TimeEntry
+	err := database.DB.Preload("Project.Client").
+		Where("user_id = ? AND date >= ? AND date <= ?", userID, datatypes.Date(period.StartDate), datatypes.Date(period.EndDate)).
+		Order("date ASC, created_at ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	dailyTotals := make(map[string]float64)
+	weeklyTotals := make(map[string]float64)
+	for _, entry := range entries {
+		date := time.Time(entry.Date)
+		dailyTotals[date.Format("2006-01-02")] += entry.Hours
+		weeklyTotals[startOfWeek(date).Format("2006-01-02")] += entry.Hours
+	}
+
+	return entries, dailyTotals, weeklyTotals, nil
+}
+
+func (s *TimeEntryService) GetMonthOvertime(userID uuid.UUID, period *MonthPeriod) (*OvertimeResult, []*ProjectBilling, error) {
+	overtime, err := NewOvertimeService().CalculateOvertime(userID, period.StartDate, period.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	billing, err := NewBillingService().CalculateBilling(userID, period.StartDate, period.EndDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return overtime, billing, nil
+}
+
+func (s *TimeEntryService) GetMonthSummary(userID uuid.UUID, period *MonthPeriod) (map[uuid.UUID]map[string]float64, error) {
+	var allocations []models.Allocation
+	err := database.DB.Where("user_id = ? AND week_starting >= ? AND week_starting <= ?",
+		userID, startOfWeek(period.StartDate), period.EndDate).Find(&allocations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := make(map[uuid.UUID]map[string]float64)
+	project := func(projectID uuid.UUID) map[string]float64 {
+		if _, exists := summary[projectID]; !exists {
+			summary[projectID] = map[string]float64{
+				"allocated":    0,
+				"actual":       0,
+				"billable":     0,
+				"non_billable": 0,
+			}
+		}
+		return summary[projectID]
+	}
+
+	for _, alloc := range allocations {
+		weekStart := alloc.WeekStarting
+		workdays := 0
+		for day := weekStart; day.Before(weekStart.AddDate(0, 0, 5)); day = day.AddDate(0, 0, 1) {
+			if !day.Before(period.StartDate) && !day.After(period.EndDate) {
+				workdays++
+			}
+		}
+		project(alloc.ProjectID)["allocated"] += alloc.Hours * float64(workdays) / 5
+	}
+
+	var entries []models.TimeEntry
+	err = database.DB.Where("user_id = ? AND date >= ? AND date <= ?",
+		userID, datatypes.Date(period.StartDate), datatypes.Date(period.EndDate)).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		hours := project(entry.ProjectID)
+		hours["actual"] += entry.Hours
+		if entry.IsBillable {
+			hours["billable"] += entry.Hours
+		} else {
+			hours["non_billable"] += entry.Hours
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *TimeEntryService) ListDeletedTimeEntries(userID uuid.UUID, offset, limit int) ([]*models.TimeEntry, int64, error) {
+	var timeEntries []*models.TimeEntry
+	var total int64
+
+	query := database.DB.Unscoped().Model(&models.TimeEntry{}).
+		Preload("Project", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Project.Client", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("deleted_at DESC").Find(&timeEntries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return timeEntries, total, nil
+}
+
+func (s *TimeEntryService) RestoreTimeEntry(userID, timeEntryID uuid.UUID) (*models.TimeEntry, error) {
+	timeEntry, err := s.getDeletedTimeEntry(userID, timeEntryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project models.Project
+	if err := database.DB.Where("id = ?", timeEntry.ProjectID).First(&project).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectDeleted
+		}
+		return nil, err
+	}
+
+	var existing models.TimeEntry
+	err = database.DB.Where("project_id = ? AND user_id = ? AND date = ?",
+		timeEntry.ProjectID, userID, timeEntry.Date).First(&existing).Error
+	if err == nil {
+		return nil, ErrTimeEntryExists
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(timeEntry).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityTimeEntry, timeEntry.ID, userID,
+			models.RevisionActionRestore, nil, timeEntrySnapshot(timeEntry))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBudgets(timeEntry.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return s.GetTimeEntry(userID, timeEntryID)
+}
+
+func (s *TimeEntryService) PurgeTimeEntry(userID, timeEntryID uuid.UUID) error {
+	timeEntry, err := s.getDeletedTimeEntry(userID, timeEntryID)
+	if err != nil {
+		return err
+	}
+
+	if timeEntry.IsLocked() {
+		return ErrTimeEntryLocked
+	}
+
+	attachmentService := NewAttachmentService()
+	var storageKeys []string
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		keys, err := attachmentService.purgeTimeEntryAttachments(tx, timeEntry.ID)
+		if err != nil {
+			return err
+		}
+		storageKeys = keys
+
+		if err := tx.Unscoped().Delete(timeEntry).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, models.RevisionEntityTimeEntry, timeEntry.ID, userID,
+			models.RevisionActionPurge, timeEntrySnapshot(timeEntry), nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	attachmentService.deleteStoredFiles(storageKeys)
+	return nil
+}
+
+func (s *TimeEntryService) getDeletedTimeEntry(userID, timeEntryID uuid.UUID) (*models.TimeEntry, error) {
+	var timeEntry models.TimeEntry
+	err := database.DB.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", timeEntryID, userID).First(&timeEntry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+	return &timeEntry, nil
+}
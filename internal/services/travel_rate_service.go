@@ -0,0 +1,185 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type TravelRateService struct{}
+
+func NewTravelRateService() *TravelRateService {
+	return &TravelRateService{}
+}
+
+var (
+	ErrMileageRateNotFound = errors.New("no mileage rate configured for this vehicle type and currency")
+	ErrPerDiemRateNotFound = errors.New("no per-diem rate configured for this country and currency")
+	ErrInvalidVehicleType  = errors.New("invalid vehicle type")
+	ErrTravelRateInUse     = errors.New("rate is referenced by existing claims")
+)
+
+func (s *TravelRateService) CreateMileageRate(vehicleType models.VehicleType, currency string, ratePerUnit float64, distanceUnit string, effectiveFrom time.Time) (*models.MileageRate, error) {
+	if !models.IsValidVehicleType(vehicleType) {
+		return nil, ErrInvalidVehicleType
+	}
+
+	rate := &models.MileageRate{
+		VehicleType:   vehicleType,
+		Currency:      strings.ToUpper(currency),
+		RatePerUnit:   ratePerUnit,
+		DistanceUnit:  distanceUnit,
+		EffectiveFrom: datatypes.Date(effectiveFrom),
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var version int
+		if err := tx.Model(&models.MileageRate{}).
+			Where("vehicle_type = ? AND currency = ?", rate.VehicleType, rate.Currency).
+			Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+			return err
+		}
+
+		rate.Version = version + 1
+		return tx.Create(rate).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (s *TravelRateService) ListMileageRates(vehicleType *models.VehicleType, currency *string) ([]*models.MileageRate, error) {
+	var rates []*models.MileageRate
+
+	query := database.DB.Model(&models.MileageRate{})
+
+	if vehicleType != nil {
+		query = query.Where("vehicle_type = ?", *vehicleType)
+	}
+
+	if currency != nil {
+		query = query.Where("currency = ?", strings.ToUpper(*currency))
+	}
+
+	err := query.Order("vehicle_type ASC, currency ASC, version DESC").Find(&rates).Error
+	return rates, err
+}
+
+func (s *TravelRateService) DeleteMileageRate(rateID uuid.UUID) error {
+	var count int64
+	if err := database.DB.Unscoped().Model(&models.MileageEntry{}).Where("rate_id = ?", rateID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTravelRateInUse
+	}
+
+	result := database.DB.Where("id = ?", rateID).Delete(&models.MileageRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrMileageRateNotFound
+	}
+	return nil
+}
+
+func (s *TravelRateService) ResolveMileageRate(vehicleType models.VehicleType, currency string, date time.Time) (*models.MileageRate, error) {
+	var rate models.MileageRate
+	err := database.DB.
+		Where("vehicle_type = ? AND currency = ? AND effective_from <= ?", vehicleType, strings.ToUpper(currency), datatypes.Date(date)).
+		Order("effective_from DESC, version DESC").
+		First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMileageRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (s *TravelRateService) CreatePerDiemRate(country, currency string, dailyRate float64, effectiveFrom time.Time) (*models.PerDiemRate, error) {
+	rate := &models.PerDiemRate{
+		Country:       strings.ToUpper(country),
+		Currency:      strings.ToUpper(currency),
+		DailyRate:     dailyRate,
+		EffectiveFrom: datatypes.Date(effectiveFrom),
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var version int
+		if err := tx.Model(&models.PerDiemRate{}).
+			Where("country = ? AND currency = ?", rate.Country, rate.Currency).
+			Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+			return err
+		}
+
+		rate.Version = version + 1
+		return tx.Create(rate).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (s *TravelRateService) ListPerDiemRates(country, currency *string) ([]*models.PerDiemRate, error) {
+	var rates []*models.PerDiemRate
+
+	query := database.DB.Model(&models.PerDiemRate{})
+
+	if country != nil {
+		query = query.Where("country = ?", strings.ToUpper(*country))
+	}
+
+	if currency != nil {
+		query = query.Where("currency = ?", strings.ToUpper(*currency))
+	}
+
+	err := query.Order("country ASC, currency ASC, version DESC").Find(&rates).Error
+	return rates, err
+}
+
+func (s *TravelRateService) DeletePerDiemRate(rateID uuid.UUID) error {
+	var count int64
+	if err := database.DB.Unscoped().Model(&models.PerDiemClaim{}).Where("rate_id = ?", rateID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTravelRateInUse
+	}
+
+	result := database.DB.Where("id = ?", rateID).Delete(&models.PerDiemRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPerDiemRateNotFound
+	}
+	return nil
+}
+
+func (s *TravelRateService) ResolvePerDiemRate(country, currency string, date time.Time) (*models.PerDiemRate, error) {
+	var rate models.PerDiemRate
+	err := database.DB.
+		Where("country = ? AND currency = ? AND effective_from <= ?", strings.ToUpper(country), strings.ToUpper(currency), datatypes.Date(date)).
+		Order("effective_from DESC, version DESC").
+		First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPerDiemRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type TravelService struct {
+	rateService *TravelRateService
+}
+
+func NewTravelService() *TravelService {
+	return &TravelService{
+		rateService: NewTravelRateService(),
+	}
+}
+
+var (
+	ErrMileageEntryNotFound = errors.New("mileage entry not found")
+	ErrPerDiemClaimNotFound = errors.New("per-diem claim not found")
+	ErrTravelClaimLocked    = errors.New("travel claim is locked by an invoice")
+)
+
+type TravelFilter struct {
+	ProjectID  *uuid.UUID
+	StartDate  *time.Time
+	EndDate    *time.Time
+	IsBillable *bool
+}
+
+func (f TravelFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.ProjectID != nil {
+		query = query.Where("project_id = ?", *f.ProjectID)
+	}
+
+	if f.StartDate != nil {
+		query = query.Where("date >= ?", datatypes.Date(*f.StartDate))
+	}
+
+	if f.EndDate != nil {
+		query = query.Where("date <= ?", datatypes.Date(*f.EndDate))
+	}
+
+	if f.IsBillable != nil {
+		query = query.Where("is_billable = ?", *f.IsBillable)
+	}
+
+	return query
+}
+
+func (s *TravelService) CreateMileageEntry(userID, projectID uuid.UUID, date time.Time, from, to string, distance float64, vehicleType models.VehicleType, description string, isBillable bool) (*models.MileageEntry, error) {
+	if date.After(time.Now()) {
+		return nil, ErrDateInFuture
+	}
+
+	if !models.IsValidVehicleType(vehicleType) {
+		return nil, ErrInvalidVehicleType
+	}
+
+	var project models.Project
+	if err := database.DB.Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+		return nil, errors.New("project not found or access denied")
+	}
+
+	entry := &models.MileageEntry{
+		ProjectID:    projectID,
+		UserID:       userID,
+		Date:         datatypes.Date(date),
+		FromLocation: from,
+		ToLocation:   to,
+		Distance:     distance,
+		VehicleType:  vehicleType,
+		Description:  description,
+		IsBillable:   isBillable,
+	}
+
+	if err := s.priceMileageEntry(entry, project.Currency); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Create(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetMileageEntry(userID, entry.ID)
+}
+
+func (s *TravelService) GetMileageEntry(userID, entryID uuid.UUID) (*models.MileageEntry, error) {
+	var entry models.MileageEntry
+	err := database.DB.Preload("Project.Client").Preload("Rate").Where("id = ? AND user_id = ?", entryID, userID).First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMileageEntryNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *TravelService) ListMileageEntries(userID uuid.UUID, filter TravelFilter, offset, limit int) ([]*models.MileageEntry, int64, error) {
+	var entries []*models.MileageEntry
+	var total int64
+
+	query := filter.apply(database.DB.Model(&models.MileageEntry{}).Preload("Project.Client").Preload("Rate").Where("user_id = ?", userID))
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("date DESC, created_at DESC").Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+func (s *TravelService) UpdateMileageEntry(userID, entryID uuid.UUID, updates map[string]interface{}) (*models.MileageEntry, error) {
+	entry, err := s.GetMileageEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.IsLocked() {
+		return nil, ErrTravelClaimLocked
+	}
+
+	reprice := false
+	if date, ok := updates["date"].(time.Time); ok {
+		if date.After(time.Now()) {
+			return nil, ErrDateInFuture
+		}
+		reprice = reprice || !time.Time(entry.Date).Equal(date)
+		entry.Date = datatypes.Date(date)
+	}
+	if from, ok := updates["from_location"].(string); ok {
+		entry.FromLocation = from
+	}
+	if to, ok := updates["to_location"].(string); ok {
+		entry.ToLocation = to
+	}
+	if distance, ok := updates["distance"].(float64); ok {
+		entry.Distance = distance
+		entry.Amount = entry.Distance * entry.RatePerUnit
+	}
+	if vehicleType, ok := updates["vehicle_type"].(string); ok {
+		if !models.IsValidVehicleType(models.VehicleType(vehicleType)) {
+			return nil, ErrInvalidVehicleType
+		}
+		reprice = reprice || entry.VehicleType != models.VehicleType(vehicleType)
+		entry.VehicleType = models.VehicleType(vehicleType)
+	}
+	if description, ok := updates["description"].(string); ok {
+		entry.Description = description
+	}
+	if isBillable, ok := updates["is_billable"].(bool); ok {
+		entry.IsBillable = isBillable
+	}
+
+	if reprice {
+		if err := s.priceMileageEntry(entry, entry.Project.Currency); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := database.DB.Omit("Project", "User", "Rate").Save(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetMileageEntry(userID, entryID)
+}
+
+func (s *TravelService) DeleteMileageEntry(userID, entryID uuid.UUID) error {
+	entry, err := s.GetMileageEntry(userID, entryID)
+	if err != nil {
+		return err
+	}
+
+	if entry.IsLocked() {
+		return ErrTravelClaimLocked
+	}
+
+	return database.DB.Delete(entry).Error
+}
+
+func (s *TravelService) priceMileageEntry(entry *models.MileageEntry, currency string) error {
+	rate, err := s.rateService.ResolveMileageRate(entry.VehicleType, currency, time.Time(entry.Date))
+	if err != nil {
+		return err
+	}
+
+	entry.RateID = rate.ID
+	entry.RatePerUnit = rate.RatePerUnit
+	entry.Currency = rate.Currency
+	entry.Amount = entry.Distance * rate.RatePerUnit
+	return nil
+}
+
+func (s *TravelService) CreatePerDiemClaim(userID, projectID uuid.UUID, date time.Time, days float64, country, description string, isBillable bool) (*models.PerDiemClaim, error) {
+	if date.After(time.Now()) {
+		return nil, ErrDateInFuture
+	}
+
+	var project models.Project
+	if err := database.DB.Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+		return nil, errors.New("project not found or access denied")
+	}
+
+	claim := &models.PerDiemClaim{
+		ProjectID:   projectID,
+		UserID:      userID,
+		Date:        datatypes.Date(date),
+		Days:        days,
+		Country:     strings.ToUpper(country),
+		Description: description,
+		IsBillable:  isBillable,
+	}
+
+	if err := s.pricePerDiemClaim(claim, project.Currency); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.Create(claim).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetPerDiemClaim(userID, claim.ID)
+}
+
+func (s *TravelService) GetPerDiemClaim(userID, claimID uuid.UUID) (*models.PerDiemClaim, error) {
+	var claim models.PerDiemClaim
+	err := database.DB.Preload("Project.Client").Preload("Rate").Where("id = ? AND user_id = ?", claimID, userID).First(&claim).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPerDiemClaimNotFound
+		}
+		return nil, err
+	}
+	return &claim, nil
+}
+
+func (s *TravelService) ListPerDiemClaims(userID uuid.UUID, filter TravelFilter, offset, limit int) ([]*models.PerDiemClaim, int64, error) {
+	var claims []*models.PerDiemClaim
+	var total int64
+
+	query := filter.apply(database.DB.Model(&models.PerDiemClaim{}).Preload("Project.Client").Preload("Rate").Where("user_id = ?", userID))
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order("date DESC, created_at DESC").Find(&claims).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return claims, total, nil
+}
+
+func (s *TravelService) UpdatePerDiemClaim(userID, claimID uuid.UUID, updates map[string]interface{}) (*models.PerDiemClaim, error) {
+	claim, err := s.GetPerDiemClaim(userID, claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	if claim.IsLocked() {
+		return nil, ErrTravelClaimLocked
+	}
+
+	reprice := false
+	if date, ok := updates["date"].(time.Time); ok {
+		if date.After(time.Now()) {
+			return nil, ErrDateInFuture
+		}
+		reprice = reprice || !time.Time(claim.Date).Equal(date)
+		claim.Date = datatypes.Date(date)
+	}
+	if days, ok := updates["days"].(float64); ok {
+		claim.Days = days
+		claim.Amount = claim.Days * claim.DailyRate
+	}
+	if country, ok := updates["country"].(string); ok {
+		reprice = reprice || claim.Country != strings.ToUpper(country)
+		claim.Country = strings.ToUpper(country)
+	}
+	if description, ok := updates["description"].(string); ok {
+		claim.Description = description
+	}
+	if isBillable, ok := updates["is_billable"].(bool); ok {
+		claim.IsBillable = isBillable
+	}
+
+	if reprice {
+		if err := s.pricePerDiemClaim(claim, claim.Project.Currency); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := database.DB.Omit("Project", "User", "Rate").Save(claim).Error; err != nil {
+		return nil, err
+	}
+
+	return s.GetPerDiemClaim(userID, claimID)
+}
+
+func (s *TravelService) DeletePerDiemClaim(userID, claimID uuid.UUID) error {
+	claim, err := s.GetPerDiemClaim(userID, claimID)
+	if err != nil {
+		return err
+	}
+
+	if claim.IsLocked() {
+		return ErrTravelClaimLocked
+	}
+
+	return database.DB.Delete(claim).Error
+}
+
+func (s *TravelService) pricePerDiemClaim(claim *models.PerDiemClaim, currency string) error {
+	rate, err := s.rateService.ResolvePerDiemRate(claim.Country, currency, time.Time(claim.Date))
+	if err != nil {
+		return err
+	}
+
+	claim.RateID = rate.ID
+	claim.DailyRate = rate.DailyRate
+	claim.Currency = rate.Currency
+	claim.Amount = claim.Days * rate.DailyRate
+	return nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/storage"
+)
+
+const MaxUploadSize = 10 << 20
+
+var allowedUploadTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+var officeUploadTypes = map[string]string{
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+var (
+	ErrFileTooLarge      = errors.New("file exceeds the maximum size of 10 MB")
+	ErrFileTypeForbidden = errors.New("file type is not allowed")
+	ErrFileEmpty         = errors.New("file is empty")
+)
+
+type storedFile struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Checksum    string
+}
+
+func saveUpload(store storage.Storage, key, fileName string, size int64, r io.Reader) (*storedFile, error) {
+	if size > MaxUploadSize {
+		return nil, ErrFileTooLarge
+	}
+
+	header := make([]byte, 512)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrFileEmpty
+	}
+	header = header[:n]
+
+	contentType, err := detectUploadType(fileName, header)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	limited := io.LimitReader(io.MultiReader(bytes.NewReader(header), r), MaxUploadSize+1)
+
+	written, err := store.Save(key, io.TeeReader(limited, hash))
+	if err != nil {
+		return nil, err
+	}
+	if written > MaxUploadSize {
+		store.Delete(key)
+		return nil, ErrFileTooLarge
+	}
+
+	return &storedFile{
+		FileName:    filepath.Base(fileName),
+		ContentType: contentType,
+		Size:        written,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func detectUploadType(fileName string, header []byte) (string, error) {
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(header))
+
+	if allowedUploadTypes[detected] {
+		return detected, nil
+	}
+
+	if detected == "application/zip" {
+		if officeType, ok := officeUploadTypes[strings.ToLower(filepath.Ext(fileName))]; ok {
+			return officeType, nil
+		}
+	}
+
+	return "", ErrFileTypeForbidden
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type UtilizationService struct{}
+
+func NewUtilizationService() *UtilizationService {
+	return &UtilizationService{}
+}
+
+var ErrInvalidUtilizationPeriod = errors.New("invalid utilization period, use week or month")
+
+type UtilizationPeriod string
+
+const (
+	UtilizationPeriodWeek  UtilizationPeriod = "week"
+	UtilizationPeriodMonth UtilizationPeriod = "month"
+)
+
+type UtilizationTotals struct {
+	CapacityHours    float64
+	HolidayHours     float64
+	LeaveHours       float64
+	TotalHours       float64
+	BillableHours    float64
+	NonBillableHours float64
+	TargetHours      float64
+}
+
+func (t *UtilizationTotals) AvailableHours() float64 {
+	return t.CapacityHours - t.HolidayHours - t.LeaveHours
+}
+
+func (t *UtilizationTotals) Utilization() float64 {
+	return t.percentOfAvailable(t.TotalHours)
+}
+
+func (t *UtilizationTotals) BillableUtilization() float64 {
+	return t.percentOfAvailable(t.BillableHours)
+}
+
+func (t *UtilizationTotals) Target() float64 {
+	return t.percentOfAvailable(t.TargetHours)
+}
+
+func (t *UtilizationTotals) percentOfAvailable(hours float64) float64 {
+	available := t.AvailableHours()
+	if available <= 0 {
+		return 0
+	}
+	return math.Round(hours/available*1000) / 10
+}
+
+func (t *UtilizationTotals) add(other UtilizationTotals) {
+	t.CapacityHours += other.CapacityHours
+	t.HolidayHours += other.HolidayHours
+	t.LeaveHours += other.LeaveHours
+	t.TotalHours += other.TotalHours
+	t.BillableHours += other.BillableHours
+	t.NonBillableHours += other.NonBillableHours
+	t.TargetHours += other.TargetHours
+}
+
+type UtilizationBucket struct {
+	StartDate time.Time
+	EndDate   time.Time
+	UtilizationTotals
+}
+
+type UserUtilization struct {
+	User    models.User
+	Target  float64
+	Periods []*UtilizationBucket
+	UtilizationTotals
+}
+
+type UtilizationReport struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Period    UtilizationPeriod
+	Users     []*UserUtilization
+	Totals    UtilizationTotals
+}
+
+func (s *UtilizationService) periodStart(date time.Time, period UtilizationPeriod) time.Time {
+	if period == UtilizationPeriodMonth {
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return startOfWeek(date)
+}
+
+func (s *UtilizationService) periodEnd(start time.Time, period UtilizationPeriod) time.Time {
+	if period == UtilizationPeriodMonth {
+		return start.AddDate(0, 1, -1)
+	}
+	return start.AddDate(0, 0, 6)
+}
+
+func (s *UtilizationService) GetUtilizationReport(startDate, endDate time.Time, period UtilizationPeriod, target *float64) (*UtilizationReport, error) {
+	if period != UtilizationPeriodWeek && period != UtilizationPeriodMonth {
+		return nil, ErrInvalidUtilizationPeriod
+	}
+
+	report := &UtilizationReport{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Period:    period,
+	}
+
+	var users []models.User
+	if err := database.DB.Where("is_active = ? AND weekly_capacity > 0", true).Order("username ASC").Find(&users).Error; err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return report, nil
+	}
+
+	userIDs := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+
+	holidays, err := NewHolidayService().HolidayDates(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	leave, err := NewLeaveService().LeaveDates(userIDs, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	type dailyTotal struct {
+		UserID     uuid.UUID
+		Date       datatypes.Date
+		IsBillable bool
+		Hours      float64
+	}
+
+	var totals []dailyTotal
+	err = database.DB.Model(&models.TimeEntry{}).
+		Select("user_id, date, is_billable, SUM(hours) AS hours").
+		Where("user_id IN ? AND date >= ? AND date <= ?", userIDs, datatypes.Date(startDate), datatypes.Date(endDate)).
+		Group("user_id, date, is_billable").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	logged := make(map[uuid.UUID]map[string]*UtilizationTotals)
+	for _, total := range totals {
+		if logged[total.UserID] == nil {
+			logged[total.UserID] = make(map[string]*UtilizationTotals)
+		}
+		key := time.Time(total.Date).Format("2006-01-02")
+		day, exists := logged[total.UserID][key]
+		if !exists {
+			day = &UtilizationTotals{}
+			logged[total.UserID][key] = day
+		}
+		day.TotalHours += total.Hours
+		if total.IsBillable {
+			day.BillableHours += total.Hours
+		} else {
+			day.NonBillableHours += total.Hours
+		}
+	}
+
+	for _, user := range users {
+		row := &UserUtilization{User: user, Target: user.UtilizationTarget}
+		if target != nil {
+			row.Target = *target
+		}
+
+		var bucket *UtilizationBucket
+		daily := user.DailyCapacity()
+		for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+			if bucket == nil || day.After(bucket.EndDate) {
+				bucketStart := s.periodStart(day, period)
+				bucket = &UtilizationBucket{
+					StartDate: day,
+					EndDate:   s.periodEnd(bucketStart, period),
+				}
+				if bucket.EndDate.After(endDate) {
+					bucket.EndDate = endDate
+				}
+				row.Periods = append(row.Periods, bucket)
+			}
+
+			var totals UtilizationTotals
+			key := day.Format("2006-01-02")
+			if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+				totals.CapacityHours = daily
+				if holidays[key] != "" {
+					totals.HolidayHours = daily
+				} else if leave[user.ID][key] != "" {
+					totals.LeaveHours = daily
+				}
+				totals.TargetHours = totals.AvailableHours() * row.Target / 100
+			}
+			if hours := logged[user.ID][key]; hours != nil {
+				totals.add(*hours)
+			}
+
+			bucket.add(totals)
+			row.add(totals)
+		}
+
+		report.Totals.add(row.UtilizationTotals)
+		report.Users = append(report.Users, row)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/SteelyBretty/consultant-time-tracker/internal/database"
+	"github.com/SteelyBretty/consultant-time-tracker/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WriteDownService struct {
+	invoiceService *InvoiceService
+}
+
+func NewWriteDownService() *WriteDownService {
+	return &WriteDownService{
+		invoiceService: NewInvoiceService(),
+	}
+}
+
+var (
+	ErrWriteDownNotFound      = errors.New("write-down not found")
+	ErrInvalidWriteDownReason = errors.New("invalid write-down reason")
+	ErrWriteDownExceedsHours  = errors.New("write-down exceeds the billable hours")
+	ErrTimeEntryNotBillable   = errors.New("time entry is not billable")
+	ErrWriteDownNotTimeLine   = errors.New("only time lines can be written down")
+)
+
+var writeDownReasons = map[models.WriteDownReason]bool{
+	models.WriteDownReasonGoodwill:     true,
+	models.WriteDownReasonInefficiency: true,
+	models.WriteDownReasonScopeDispute: true,
+	models.WriteDownReasonRateDispute:  true,
+	models.WriteDownReasonTraining:     true,
+	models.WriteDownReasonOther:        true,
+}
+
+func (s *WriteDownService) WriteDownTimeEntry(userID, timeEntryID uuid.UUID, hours float64, reason models.WriteDownReason, notes string) (*models.WriteDown, error) {
+	if !writeDownReasons[reason] {
+		return nil, ErrInvalidWriteDownReason
+	}
+
+	var entry models.TimeEntry
+	if err := database.DB.Where("id = ? AND user_id = ?", timeEntryID, userID).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	if entry.IsLocked() {
+		return nil, ErrTimeEntryLocked
+	}
+	if !entry.IsBillable {
+		return nil, ErrTimeEntryNotBillable
+	}
+	if hours > entry.BillableHours()+0.001 {
+		return nil, ErrWriteDownExceedsHours
+	}
+
+	writeDown := &models.WriteDown{
+		UserID:      userID,
+		TimeEntryID: &entry.ID,
+		Hours:       hours,
+		Reason:      reason,
+		Notes:       strings.TrimSpace(notes),
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(writeDown).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entry).Update("written_down_hours", entry.WrittenDownHours+hours).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return writeDown, nil
+}
+
+func (s *WriteDownService) WriteDownInvoiceLine(userID, invoiceID, lineID uuid.UUID, hours float64, reason models.WriteDownReason, notes string) (*models.WriteDown, error) {
+	if !writeDownReasons[reason] {
+		return nil, ErrInvalidWriteDownReason
+	}
+
+	invoice, err := s.invoiceService.GetInvoice(userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !invoice.IsEditable() {
+		return nil, ErrInvalidInvoiceTransition
+	}
+
+	var line *models.InvoiceLine
+	for i := range invoice.Lines {
+		if invoice.Lines[i].ID == lineID {
+			line = &invoice.Lines[i]
+		}
+	}
+	if line == nil {
+		return nil, ErrInvoiceLineNotFound
+	}
+	if !line.IsTime() {
+		return nil, ErrWriteDownNotTimeLine
+	}
+	if hours > line.Hours+0.001 {
+		return nil, ErrWriteDownExceedsHours
+	}
+
+	writeDown := &models.WriteDown{
+		UserID:        userID,
+		InvoiceID:     &invoice.ID,
+		InvoiceLineID: &line.ID,
+		Hours:         hours,
+		Reason:        reason,
+		Notes:         strings.TrimSpace(notes),
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(writeDown).Error; err != nil {
+			return err
+		}
+		return s.adjustInvoiceLine(tx, invoice, line, -hours)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return writeDown, nil
+}
+
+func (s *WriteDownService) adjustInvoiceLine(tx *gorm.DB, invoice *models.Invoice, line *models.InvoiceLine, delta float64) error {
+	line.Hours += delta
+	line.WrittenDownHours -= delta
+	line.Amount = roundMoney(line.Hours * line.Rate)
+	if err := tx.Model(line).Updates(map[string]interface{}{
+		"hours":              line.Hours,
+		"written_down_hours": line.WrittenDownHours,
+		"amount":             line.Amount,
+	}).Error; err != nil {
+		return err
+	}
+
+	invoice.TotalHours = 0
+	invoice.Subtotal = 0
+	for _, l := range invoice.Lines {
+		invoice.TotalHours += l.Hours
+		invoice.Subtotal += l.Amount
+	}
+	if err := tx.Model(invoice).Updates(map[string]interface{}{
+		"total_hours": invoice.TotalHours,
+		"subtotal":    invoice.Subtotal,
+	}).Error; err != nil {
+		return err
+	}
+
+	return NewTaxService().applyInvoiceTax(tx, invoice, &invoice.Client, time.Now())
+}
+
+func (s *WriteDownService) ListTimeEntryWriteDowns(userID, timeEntryID uuid.UUID) ([]*models.WriteDown, error) {
+	var entry models.TimeEntry
+	if err := database.DB.Unscoped().Where("id = ? AND user_id = ?", timeEntryID, userID).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	var writeDowns []*models.WriteDown
+	err := database.DB.Where("time_entry_id = ?", entry.ID).Order("created_at ASC").Find(&writeDowns).Error
+	return writeDowns, err
+}
+
+func (s *WriteDownService) ListInvoiceWriteDowns(userID, invoiceID uuid.UUID) ([]*models.WriteDown, error) {
+	if _, err := s.invoiceService.GetInvoice(userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	var writeDowns []*models.WriteDown
+	err := database.DB.
+		Where("invoice_id = ? OR time_entry_id IN (?)", invoiceID,
+			database.DB.Unscoped().Model(&models.TimeEntry{}).Select("id").Where("invoice_id = ?", invoiceID)).
+		Order("created_at ASC").
+		Find(&writeDowns).Error
+	return writeDowns, err
+}
+
+func (s *WriteDownService) DeleteWriteDown(userID, writeDownID uuid.UUID) error {
+	var writeDown models.WriteDown
+	if err := database.DB.Where("id = ? AND user_id = ?", writeDownID, userID).First(&writeDown).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrWriteDownNotFound
+		}
+		return err
+	}
+
+	if writeDown.TimeEntryID != nil {
+		var entry models.TimeEntry
+		if err := database.DB.Unscoped().Where("id = ?", *writeDown.TimeEntryID).First(&entry).Error; err != nil {
+			return err
+		}
+		if entry.IsLocked() {
+			return ErrTimeEntryLocked
+		}
+
+		return database.DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Delete(&writeDown).Error; err != nil {
+				return err
+			}
+			return tx.Unscoped().Model(&entry).Update("written_down_hours", entry.WrittenDownHours-writeDown.Hours).Error
+		})
+	}
+
+	invoice, err := s.invoiceService.GetInvoice(userID, *writeDown.InvoiceID)
+	if err != nil {
+		return err
+	}
+	if !invoice.IsEditable() {
+		return ErrInvalidInvoiceTransition
+	}
+
+	var line *models.InvoiceLine
+	for i := range invoice.Lines {
+		if invoice.Lines[i].ID == *writeDown.InvoiceLineID {
+			line = &invoice.Lines[i]
+		}
+	}
+	if line == nil {
+		return ErrInvoiceLineNotFound
+	}
+
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&writeDown).Error; err != nil {
+			return err
+		}
+		return s.adjustInvoiceLine(tx, invoice, line, writeDown.Hours)
+	})
+}
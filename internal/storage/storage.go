@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+var ErrObjectNotFound = errors.New("stored object not found")
+
+type Storage interface {
+	Save(key string, r io.Reader) (int64, error)
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+func NewDefaultStorage() Storage {
+	basePath := os.Getenv("ATTACHMENTS_PATH")
+	if basePath == "" {
+		basePath = "./data/attachments"
+	}
+	return NewLocalStorage(basePath)
+}
@@ -0,0 +1,366 @@
+package ubl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type content int
+
+const (
+	aggregate content = iota
+	text
+	code
+	identifier
+	date
+	decimal
+	amount
+	quantity
+)
+
+type occurrence struct {
+	min, max int
+}
+
+var (
+	optional   = occurrence{0, 1}
+	required   = occurrence{1, 1}
+	repeated   = occurrence{0, -1}
+	atLeastOne = occurrence{1, -1}
+)
+
+type schemaElement struct {
+	name     string
+	content  content
+	occurs   occurrence
+	children []*schemaElement
+}
+
+func cbc(name string, content content, occurs occurrence) *schemaElement {
+	return &schemaElement{name: name, content: content, occurs: occurs}
+}
+
+func cac(name string, occurs occurrence, children ...*schemaElement) *schemaElement {
+	return &schemaElement{name: name, content: aggregate, occurs: occurs, children: children}
+}
+
+func periodType(name string, occurs occurrence) *schemaElement {
+	return cac(name, occurs,
+		cbc("StartDate", date, optional),
+		cbc("EndDate", date, optional),
+		cbc("DescriptionCode", code, repeated),
+	)
+}
+
+func taxSchemeType() *schemaElement {
+	return cac("TaxScheme", required,
+		cbc("ID", identifier, optional),
+	)
+}
+
+func taxCategoryType(name string, occurs occurrence) *schemaElement {
+	return cac(name, occurs,
+		cbc("ID", identifier, optional),
+		cbc("Name", text, optional),
+		cbc("Percent", decimal, optional),
+		cbc("TaxExemptionReasonCode", code, optional),
+		cbc("TaxExemptionReason", text, repeated),
+		taxSchemeType(),
+	)
+}
+
+func partyType() *schemaElement {
+	return cac("Party", required,
+		cbc("EndpointID", identifier, optional),
+		cac("PartyIdentification", repeated,
+			cbc("ID", identifier, required),
+		),
+		cac("PartyName", repeated,
+			cbc("Name", text, required),
+		),
+		cac("PostalAddress", optional,
+			cbc("StreetName", text, optional),
+			cbc("AdditionalStreetName", text, optional),
+			cbc("CityName", text, optional),
+			cbc("PostalZone", text, optional),
+			cbc("CountrySubentity", text, optional),
+			cac("AddressLine", repeated,
+				cbc("Line", text, required),
+			),
+			cac("Country", optional,
+				cbc("IdentificationCode", code, optional),
+			),
+		),
+		cac("PartyTaxScheme", repeated,
+			cbc("CompanyID", identifier, optional),
+			taxSchemeType(),
+		),
+		cac("PartyLegalEntity", repeated,
+			cbc("RegistrationName", text, optional),
+			cbc("CompanyID", identifier, optional),
+			cbc("CompanyLegalForm", text, optional),
+		),
+		cac("Contact", optional,
+			cbc("Name", text, optional),
+			cbc("Telephone", text, optional),
+			cbc("ElectronicMail", text, optional),
+		),
+	)
+}
+
+var invoiceSchema = cac("Invoice", required,
+	cbc("UBLVersionID", identifier, optional),
+	cbc("CustomizationID", identifier, optional),
+	cbc("ProfileID", identifier, optional),
+	cbc("ID", identifier, required),
+	cbc("IssueDate", date, required),
+	cbc("DueDate", date, optional),
+	cbc("InvoiceTypeCode", code, optional),
+	cbc("Note", text, repeated),
+	cbc("TaxPointDate", date, optional),
+	cbc("DocumentCurrencyCode", code, optional),
+	cbc("TaxCurrencyCode", code, optional),
+	cbc("AccountingCost", text, optional),
+	cbc("BuyerReference", text, optional),
+	periodType("InvoicePeriod", repeated),
+	cac("OrderReference", optional,
+		cbc("ID", identifier, required),
+		cbc("SalesOrderID", identifier, optional),
+	),
+	cac("AccountingSupplierParty", required, partyType()),
+	cac("AccountingCustomerParty", required, partyType()),
+	cac("PaymentMeans", repeated,
+		cbc("PaymentMeansCode", code, required),
+		cbc("PaymentID", identifier, repeated),
+		cac("PayeeFinancialAccount", optional,
+			cbc("ID", identifier, optional),
+			cbc("Name", text, optional),
+		),
+	),
+	cac("PaymentTerms", repeated,
+		cbc("Note", text, repeated),
+	),
+	cac("TaxTotal", repeated,
+		cbc("TaxAmount", amount, required),
+		cac("TaxSubtotal", repeated,
+			cbc("TaxableAmount", amount, optional),
+			cbc("TaxAmount", amount, required),
+			taxCategoryType("TaxCategory", required),
+		),
+	),
+	cac("LegalMonetaryTotal", required,
+		cbc("LineExtensionAmount", amount, optional),
+		cbc("TaxExclusiveAmount", amount, optional),
+		cbc("TaxInclusiveAmount", amount, optional),
+		cbc("AllowanceTotalAmount", amount, optional),
+		cbc("ChargeTotalAmount", amount, optional),
+		cbc("PrepaidAmount", amount, optional),
+		cbc("PayableRoundingAmount", amount, optional),
+		cbc("PayableAmount", amount, required),
+	),
+	cac("InvoiceLine", atLeastOne,
+		cbc("ID", identifier, required),
+		cbc("Note", text, repeated),
+		cbc("InvoicedQuantity", quantity, optional),
+		cbc("LineExtensionAmount", amount, required),
+		cbc("AccountingCost", text, optional),
+		periodType("InvoicePeriod", repeated),
+		cac("Item", required,
+			cbc("Description", text, repeated),
+			cbc("Name", text, optional),
+			cac("BuyersItemIdentification", optional,
+				cbc("ID", identifier, required),
+			),
+			cac("SellersItemIdentification", optional,
+				cbc("ID", identifier, required),
+			),
+			taxCategoryType("ClassifiedTaxCategory", repeated),
+		),
+		cac("Price", optional,
+			cbc("PriceAmount", amount, required),
+			cbc("BaseQuantity", quantity, optional),
+		),
+	),
+)
+
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+type schemaValidator struct {
+	decoder  *xml.Decoder
+	problems []string
+}
+
+func ValidateSchema(r io.Reader) []string {
+	v := &schemaValidator{decoder: xml.NewDecoder(r)}
+	for {
+		token, err := v.decoder.Token()
+		if err == io.EOF {
+			return append(v.problems, "document has no Invoice element")
+		}
+		if err != nil {
+			return append(v.problems, "document is not well-formed XML: "+err.Error())
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Space != InvoiceNamespace || start.Name.Local != invoiceSchema.name {
+				return append(v.problems, fmt.Sprintf("root element must be Invoice in namespace %s", InvoiceNamespace))
+			}
+			if err := v.element(start, invoiceSchema, "/Invoice"); err != nil {
+				v.problems = append(v.problems, "document is not well-formed XML: "+err.Error())
+			}
+			return v.problems
+		}
+	}
+}
+
+func (v *schemaValidator) report(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *schemaValidator) element(start xml.StartElement, schema *schemaElement, path string) error {
+	if schema.content != aggregate {
+		return v.leaf(start, schema, path)
+	}
+
+	position, count := 0, 0
+	for {
+		token, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			childPath := path + "/" + token.Name.Local
+			index := -1
+			for i := position; i < len(schema.children); i++ {
+				if schema.children[i].name == token.Name.Local {
+					index = i
+					break
+				}
+			}
+
+			if index < 0 {
+				if v.declared(schema, token.Name.Local) {
+					v.report(childPath, "element is out of order")
+				} else {
+					v.report(childPath, "element is not allowed here")
+				}
+				if err := v.decoder.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if index != position {
+				v.missing(schema.children[position:index], count, path)
+				position, count = index, 0
+			}
+			child := schema.children[index]
+			count++
+			if child.occurs.max > 0 && count > child.occurs.max {
+				v.report(childPath, "element may occur at most %d time(s)", child.occurs.max)
+			}
+
+			namespace := AggregateNamespace
+			if child.content != aggregate {
+				namespace = BasicNamespace
+			}
+			if token.Name.Space != namespace {
+				v.report(childPath, "element must be in namespace %s", namespace)
+			}
+
+			if err := v.element(token, child, childPath); err != nil {
+				return err
+			}
+		case xml.CharData:
+			if strings.TrimSpace(string(token)) != "" {
+				v.report(path, "aggregate element must not contain text")
+			}
+		case xml.EndElement:
+			if position < len(schema.children) {
+				v.missing(schema.children[position:], count, path)
+			}
+			return nil
+		}
+	}
+}
+
+func (v *schemaValidator) declared(schema *schemaElement, name string) bool {
+	for _, child := range schema.children {
+		if child.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *schemaValidator) missing(children []*schemaElement, count int, path string) {
+	for i, child := range children {
+		seen := 0
+		if i == 0 {
+			seen = count
+		}
+		if seen < child.occurs.min {
+			v.report(path+"/"+child.name, "required element is missing")
+		}
+	}
+}
+
+func (v *schemaValidator) leaf(start xml.StartElement, schema *schemaElement, path string) error {
+	var value strings.Builder
+	for {
+		token, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token := token.(type) {
+		case xml.CharData:
+			value.Write(token)
+		case xml.StartElement:
+			v.report(path+"/"+token.Name.Local, "element is not allowed here")
+			if err := v.decoder.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			v.content(start, schema, strings.TrimSpace(value.String()), path)
+			return nil
+		}
+	}
+}
+
+func (v *schemaValidator) content(start xml.StartElement, schema *schemaElement, value, path string) {
+	if value == "" {
+		v.report(path, "element must not be empty")
+		return
+	}
+
+	attribute := func(name string) string {
+		for _, attr := range start.Attr {
+			if attr.Name.Space == "" && attr.Name.Local == name {
+				return attr.Value
+			}
+		}
+		return ""
+	}
+
+	switch schema.content {
+	case date:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			v.report(path, "%q is not a valid date", value)
+		}
+	case amount:
+		if attribute("currencyID") == "" {
+			v.report(path, "currencyID attribute is required")
+		}
+		fallthrough
+	case decimal, quantity:
+		if !decimalPattern.MatchString(value) {
+			v.report(path, "%q is not a valid decimal", value)
+		}
+	}
+}
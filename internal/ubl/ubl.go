@@ -0,0 +1,320 @@
+package ubl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+const (
+	InvoiceNamespace   = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	AggregateNamespace = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	BasicNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+
+	PeppolCustomizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	PeppolProfileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+
+	InvoiceTypeCommercial = "380"
+	PaymentMeansTransfer  = "30"
+	UnitHour              = "HUR"
+	UnitDay               = "DAY"
+	UnitKilometre         = "KMT"
+	UnitMile              = "SMI"
+	UnitOne               = "C62"
+	TaxSchemeVAT          = "VAT"
+)
+
+const (
+	TaxCategoryStandard      = "S"
+	TaxCategoryZero          = "Z"
+	TaxCategoryExempt        = "E"
+	TaxCategoryReverseCharge = "AE"
+)
+
+type Amount struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+func NewAmount(value float64, currency string) Amount {
+	return Amount{CurrencyID: currency, Value: strconv.FormatFloat(math.Round(value*100)/100, 'f', 2, 64)}
+}
+
+func (a Amount) Float() float64 {
+	value, _ := strconv.ParseFloat(a.Value, 64)
+	return value
+}
+
+type Quantity struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    string `xml:",chardata"`
+}
+
+func NewQuantity(value float64, unit string) Quantity {
+	return Quantity{UnitCode: unit, Value: strconv.FormatFloat(value, 'f', -1, 64)}
+}
+
+type Identifier struct {
+	SchemeID string `xml:"schemeID,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+type Invoice struct {
+	XMLName                 xml.Name       `xml:"Invoice"`
+	Namespace               string         `xml:"xmlns,attr"`
+	AggregateNamespace      string         `xml:"xmlns:cac,attr"`
+	BasicNamespace          string         `xml:"xmlns:cbc,attr"`
+	CustomizationID         string         `xml:"cbc:CustomizationID"`
+	ProfileID               string         `xml:"cbc:ProfileID"`
+	ID                      string         `xml:"cbc:ID"`
+	IssueDate               string         `xml:"cbc:IssueDate"`
+	DueDate                 string         `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode         string         `xml:"cbc:InvoiceTypeCode"`
+	Note                    string         `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode    string         `xml:"cbc:DocumentCurrencyCode"`
+	BuyerReference          string         `xml:"cbc:BuyerReference,omitempty"`
+	InvoicePeriod           *Period        `xml:"cac:InvoicePeriod,omitempty"`
+	AccountingSupplierParty PartyRole      `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty PartyRole      `xml:"cac:AccountingCustomerParty"`
+	PaymentMeans            []PaymentMeans `xml:"cac:PaymentMeans,omitempty"`
+	PaymentTerms            *PaymentTerms  `xml:"cac:PaymentTerms,omitempty"`
+	TaxTotal                []TaxTotal     `xml:"cac:TaxTotal"`
+	LegalMonetaryTotal      MonetaryTotal  `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLines            []InvoiceLine  `xml:"cac:InvoiceLine"`
+}
+
+type Period struct {
+	StartDate string `xml:"cbc:StartDate,omitempty"`
+	EndDate   string `xml:"cbc:EndDate,omitempty"`
+}
+
+type PartyRole struct {
+	Party Party `xml:"cac:Party"`
+}
+
+type Party struct {
+	EndpointID       Identifier       `xml:"cbc:EndpointID"`
+	PartyName        *PartyName       `xml:"cac:PartyName,omitempty"`
+	PostalAddress    PostalAddress    `xml:"cac:PostalAddress"`
+	PartyTaxScheme   *PartyTaxScheme  `xml:"cac:PartyTaxScheme,omitempty"`
+	PartyLegalEntity PartyLegalEntity `xml:"cac:PartyLegalEntity"`
+	Contact          *Contact         `xml:"cac:Contact,omitempty"`
+}
+
+type PartyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+type PostalAddress struct {
+	StreetName           string       `xml:"cbc:StreetName,omitempty"`
+	AdditionalStreetName string       `xml:"cbc:AdditionalStreetName,omitempty"`
+	AddressLine          *AddressLine `xml:"cac:AddressLine,omitempty"`
+	Country              Country      `xml:"cac:Country"`
+}
+
+type AddressLine struct {
+	Line string `xml:"cbc:Line"`
+}
+
+type Country struct {
+	IdentificationCode string `xml:"cbc:IdentificationCode"`
+}
+
+type PartyTaxScheme struct {
+	CompanyID string    `xml:"cbc:CompanyID"`
+	TaxScheme TaxScheme `xml:"cac:TaxScheme"`
+}
+
+type TaxScheme struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type PartyLegalEntity struct {
+	RegistrationName string `xml:"cbc:RegistrationName"`
+}
+
+type Contact struct {
+	Telephone      string `xml:"cbc:Telephone,omitempty"`
+	ElectronicMail string `xml:"cbc:ElectronicMail,omitempty"`
+}
+
+type PaymentMeans struct {
+	PaymentMeansCode      string            `xml:"cbc:PaymentMeansCode"`
+	PaymentID             string            `xml:"cbc:PaymentID,omitempty"`
+	PayeeFinancialAccount *FinancialAccount `xml:"cac:PayeeFinancialAccount,omitempty"`
+}
+
+type FinancialAccount struct {
+	ID   string `xml:"cbc:ID"`
+	Name string `xml:"cbc:Name,omitempty"`
+}
+
+type PaymentTerms struct {
+	Note string `xml:"cbc:Note"`
+}
+
+type TaxTotal struct {
+	TaxAmount    Amount        `xml:"cbc:TaxAmount"`
+	TaxSubtotals []TaxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+type TaxSubtotal struct {
+	TaxableAmount Amount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     Amount      `xml:"cbc:TaxAmount"`
+	TaxCategory   TaxCategory `xml:"cac:TaxCategory"`
+}
+
+type TaxCategory struct {
+	ID                     string    `xml:"cbc:ID"`
+	Percent                string    `xml:"cbc:Percent"`
+	TaxExemptionReasonCode string    `xml:"cbc:TaxExemptionReasonCode,omitempty"`
+	TaxExemptionReason     string    `xml:"cbc:TaxExemptionReason,omitempty"`
+	TaxScheme              TaxScheme `xml:"cac:TaxScheme"`
+}
+
+type MonetaryTotal struct {
+	LineExtensionAmount Amount  `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount  Amount  `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount  Amount  `xml:"cbc:TaxInclusiveAmount"`
+	PrepaidAmount       *Amount `xml:"cbc:PrepaidAmount,omitempty"`
+	PayableAmount       Amount  `xml:"cbc:PayableAmount"`
+}
+
+type InvoiceLine struct {
+	ID                  string   `xml:"cbc:ID"`
+	Note                string   `xml:"cbc:Note,omitempty"`
+	InvoicedQuantity    Quantity `xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount Amount   `xml:"cbc:LineExtensionAmount"`
+	Item                Item     `xml:"cac:Item"`
+	Price               Price    `xml:"cac:Price"`
+}
+
+type Item struct {
+	Name                      string              `xml:"cbc:Name"`
+	SellersItemIdentification *ItemIdentification `xml:"cac:SellersItemIdentification,omitempty"`
+	ClassifiedTaxCategory     TaxCategory         `xml:"cac:ClassifiedTaxCategory"`
+}
+
+type ItemIdentification struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type Price struct {
+	PriceAmount Amount `xml:"cbc:PriceAmount"`
+}
+
+func NewInvoice() *Invoice {
+	return &Invoice{
+		Namespace:          InvoiceNamespace,
+		AggregateNamespace: AggregateNamespace,
+		BasicNamespace:     BasicNamespace,
+		CustomizationID:    PeppolCustomizationID,
+		ProfileID:          PeppolProfileID,
+		InvoiceTypeCode:    InvoiceTypeCommercial,
+	}
+}
+
+func (i *Invoice) Encode(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(i); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (i *Invoice) Validate() []string {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(i.ID != "", "BR-02: invoice number is required")
+	check(i.IssueDate != "", "BR-03: issue date is required")
+	check(len(i.DocumentCurrencyCode) == 3, "BR-05: document currency code is required")
+	check(i.BuyerReference != "", "PEPPOL-EN16931-R003: buyer reference is required")
+	check(len(i.InvoiceLines) > 0, "BR-16: at least one invoice line is required")
+
+	seller := i.AccountingSupplierParty.Party
+	check(seller.PartyLegalEntity.RegistrationName != "", "BR-06: seller name is required")
+	check(seller.EndpointID.Value != "" && seller.EndpointID.SchemeID != "", "PEPPOL-EN16931-R020: seller electronic address is required")
+	check(len(seller.PostalAddress.Country.IdentificationCode) == 2, "BR-09: seller country code is required")
+
+	buyer := i.AccountingCustomerParty.Party
+	check(buyer.PartyLegalEntity.RegistrationName != "", "BR-07: buyer name is required")
+	check(buyer.EndpointID.Value != "" && buyer.EndpointID.SchemeID != "", "PEPPOL-EN16931-R010: buyer electronic address is required")
+	check(len(buyer.PostalAddress.Country.IdentificationCode) == 2, "BR-11: buyer country code is required")
+
+	var lineTotal int64
+	categories := make(map[string]bool)
+	for _, line := range i.InvoiceLines {
+		check(line.ID != "", "BR-21: invoice line identifier is required")
+		check(line.Item.Name != "", "BR-25: item name is required on line %s", line.ID)
+		check(line.Price.PriceAmount.Float() >= 0, "BR-27: item price must not be negative on line %s", line.ID)
+		check(line.LineExtensionAmount.CurrencyID == i.DocumentCurrencyCode, "line %s currency differs from the document currency", line.ID)
+		categories[line.Item.ClassifiedTaxCategory.ID+"/"+line.Item.ClassifiedTaxCategory.Percent] = true
+		lineTotal += cents(line.LineExtensionAmount)
+	}
+
+	totals := i.LegalMonetaryTotal
+	check(lineTotal == cents(totals.LineExtensionAmount), "BR-CO-10: line extension amount must equal the sum of the lines")
+	check(cents(totals.TaxExclusiveAmount) == cents(totals.LineExtensionAmount), "BR-CO-13: tax exclusive amount must equal the line extension amount")
+
+	sellerVAT := seller.PartyTaxScheme != nil && seller.PartyTaxScheme.CompanyID != ""
+
+	var taxTotal int64
+	check(len(i.TaxTotal) == 1, "BR-CO-18: exactly one tax total is required")
+	for _, total := range i.TaxTotal {
+		var subtotalTax int64
+		for _, subtotal := range total.TaxSubtotals {
+			category := subtotal.TaxCategory
+			check(categories[category.ID+"/"+category.Percent], "BR-CO-18: tax breakdown %s does not match any line", category.ID)
+			delete(categories, category.ID+"/"+category.Percent)
+			check(sellerVAT, "BR-%s-02: seller VAT identifier is required for tax category %s", category.ID, category.ID)
+			switch category.ID {
+			case TaxCategoryStandard:
+				expected := int64(math.Round(float64(cents(subtotal.TaxableAmount)) * percent(category) / 100))
+				difference := cents(subtotal.TaxAmount) - expected
+				check(difference > -100 && difference < 100, "BR-CO-17: tax amount for category %s must equal taxable amount times rate", category.ID)
+			case TaxCategoryExempt, TaxCategoryReverseCharge:
+				check(category.TaxExemptionReason != "" || category.TaxExemptionReasonCode != "", "BR-%s-10: exemption reason is required", category.ID)
+				fallthrough
+			default:
+				check(cents(subtotal.TaxAmount) == 0, "BR-%s-09: tax amount must be zero", category.ID)
+			}
+			if category.ID == TaxCategoryReverseCharge {
+				check(i.AccountingCustomerParty.Party.PartyTaxScheme != nil, "BR-AE-02: buyer VAT identifier is required for reverse charge")
+			}
+			subtotalTax += cents(subtotal.TaxAmount)
+		}
+		check(subtotalTax == cents(total.TaxAmount), "BR-CO-14: tax total must equal the sum of the tax subtotals")
+		taxTotal += cents(total.TaxAmount)
+	}
+	check(len(categories) == 0, "BR-CO-18: every line tax category needs a tax breakdown")
+
+	check(cents(totals.TaxInclusiveAmount) == cents(totals.TaxExclusiveAmount)+taxTotal, "BR-CO-15: tax inclusive amount must equal tax exclusive amount plus tax")
+	prepaid := int64(0)
+	if totals.PrepaidAmount != nil {
+		prepaid = cents(*totals.PrepaidAmount)
+	}
+	check(cents(totals.PayableAmount) == cents(totals.TaxInclusiveAmount)-prepaid, "BR-CO-16: payable amount must equal tax inclusive amount minus prepaid amount")
+
+	return problems
+}
+
+func percent(category TaxCategory) float64 {
+	value, _ := strconv.ParseFloat(category.Percent, 64)
+	return value
+}
+
+func cents(amount Amount) int64 {
+	return int64(math.Round(amount.Float() * 100))
+}